@@ -0,0 +1,227 @@
+package wbdata
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// ConceptCountry is the concept ID for countries
+	ConceptCountry = "country"
+	// ConceptSeries is the concept ID for series (indicators)
+	ConceptSeries = "series"
+	// ConceptTime is the concept ID for time
+	ConceptTime = "time"
+	// ConceptVersion is the concept ID for versions of archive sources
+	ConceptVersion = "version"
+
+	dimensionSelectorAll = "all"
+)
+
+type (
+	// AdvancedDataService ...
+	AdvancedDataService service
+
+	// AdvancedParams contains parameters for the Advanced Data API
+	AdvancedParams struct {
+		SourceID string
+		// Dimensions are the dimension selectors, in the order of the request path
+		Dimensions []*DimensionSelector
+	}
+
+	// DimensionSelector selects the values of a concept.
+	// If both IDs and Start/End are empty, all values are selected.
+	DimensionSelector struct {
+		// Concept is the concept ID, e.g. ConceptCountry. Concept.Selector builds a selector from SourcesService.ListConcepts
+		Concept string
+		// IDs are the variable IDs, e.g. JPN and USA for ConceptCountry
+		IDs []string
+		// Start and End are a range of variable IDs, e.g. YR2015 and YR2020 for ConceptTime
+		Start string
+		End   string
+	}
+
+	// AdvancedDataValue represents a value of the Advanced Data API
+	AdvancedDataValue struct {
+		// Variables are the variables of every dimension
+		Variables []*Variable `json:"variable"`
+		Value     float64     `json:"value"`
+
+		// Null is true when the API returns null as the value. Value is 0 then.
+		Null bool `json:"-"`
+	}
+
+	// Variable represents a variable of a dimension
+	Variable struct {
+		Concept string `json:"concept"`
+		ID      string `json:"id"`
+		Value   string `json:"value"`
+	}
+
+	advancedDataResponse struct {
+		PageSummaryWithSourceID
+		Source advancedDataSources `json:"source"`
+	}
+
+	advancedDataSource struct {
+		ID   string               `json:"id"`
+		Name string               `json:"name"`
+		Data []*AdvancedDataValue `json:"data"`
+	}
+
+	advancedDataSources []*advancedDataSource
+)
+
+// List returns a Response's Summary and values by the dimension selectors
+func (a *AdvancedDataService) List(
+	params *AdvancedParams,
+	pages *PageParams,
+) (*PageSummaryWithSourceID, []*AdvancedDataValue, error) {
+	path, err := params.path()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := a.client.NewRequest("GET", path, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := pages.addPageParams(req); err != nil {
+		return nil, nil, err
+	}
+
+	res := &advancedDataResponse{}
+	if err = a.client.do(req, res); err != nil {
+		return nil, nil, err
+	}
+
+	summary := &res.PageSummaryWithSourceID
+	values := []*AdvancedDataValue{}
+	for _, source := range res.Source {
+		summary.SourceID = source.ID
+		values = append(values, source.Data...)
+	}
+
+	return summary, values, nil
+}
+
+func (params *AdvancedParams) path() (string, error) {
+	if params == nil || params.SourceID == "" {
+		return "", errors.New("source id is required")
+	}
+
+	elems := []string{"sources", params.SourceID}
+	for _, d := range params.Dimensions {
+		selector, err := d.selector()
+		if err != nil {
+			return "", err
+		}
+		elems = append(elems, d.Concept, selector)
+	}
+
+	return strings.Join(elems, "/"), nil
+}
+
+func (d *DimensionSelector) selector() (string, error) {
+	if d.Concept == "" {
+		return "", fmt.Errorf("concept is required. dimension selector: %v", d)
+	}
+	if len(d.IDs) != 0 && (d.Start != "" || d.End != "") {
+		return "", fmt.Errorf("IDs and range should not be specified together. dimension selector: %v", d)
+	}
+	if (d.Start == "") != (d.End == "") {
+		return "", fmt.Errorf("both start and end are required for range. dimension selector: %v", d)
+	}
+
+	switch {
+	case len(d.IDs) != 0:
+		return strings.Join(d.IDs, ";"), nil
+	case d.Start != "":
+		return d.Start + ":" + d.End, nil
+	default:
+		return dimensionSelectorAll, nil
+	}
+}
+
+// Variable returns the variable of the concept. The concept is case insensitive.
+// It returns nil if the value has no such dimension.
+func (av *AdvancedDataValue) Variable(concept string) *Variable {
+	for _, v := range av.Variables {
+		if strings.EqualFold(v.Concept, concept) {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// IsNull reports whether the value is null in the API response
+func (av *AdvancedDataValue) IsNull() bool {
+	return av.Null
+}
+
+// UnmarshalJSON decodes a value and keeps whether the value is null
+func (av *AdvancedDataValue) UnmarshalJSON(data []byte) error {
+	type advancedDataValue AdvancedDataValue
+	aux := struct {
+		*advancedDataValue
+		Value *float64 `json:"value"`
+	}{
+		advancedDataValue: (*advancedDataValue)(av),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	if aux.Value == nil {
+		av.Value = 0
+		av.Null = true
+	} else {
+		av.Value = *aux.Value
+		av.Null = false
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes a value, with null as the value if it is null
+func (av AdvancedDataValue) MarshalJSON() ([]byte, error) {
+	type advancedDataValue AdvancedDataValue
+
+	return json.Marshal(struct {
+		advancedDataValue
+		Value *float64 `json:"value"`
+	}{
+		advancedDataValue: advancedDataValue(av),
+		Value:             av.nullableValue(),
+	})
+}
+
+// nullableValue returns the value, or nil if it is null
+func (av *AdvancedDataValue) nullableValue() *float64 {
+	if av.Null {
+		return nil
+	}
+	v := av.Value
+
+	return &v
+}
+
+func (s *advancedDataSources) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(objectOrArray(data), (*[]*advancedDataSource)(s))
+}
+
+// objectOrArray wraps a JSON object in an array.
+// The API returns the source of the Advanced Data API as an object or an array.
+func objectOrArray(data []byte) []byte {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) != 0 && trimmed[0] == '{' {
+		return append(append([]byte{'['}, trimmed...), ']')
+	}
+
+	return data
+}
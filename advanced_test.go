@@ -0,0 +1,277 @@
+package wbdata
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/jkkitakita/wbdata-go/testutils"
+)
+
+func TestAdvancedDataService_List(t *testing.T) {
+	client, save := NewTestClient(t, *update)
+	defer save()
+
+	optIgnoreFields := cmpopts.IgnoreFields(
+		PageSummaryWithSourceID{},
+		"Pages",
+		"Total",
+		"LastUpdated",
+	)
+
+	defaultPageParams := &PageParams{
+		Page:    testutils.TestDefaultPage,
+		PerPage: testutils.TestDefaultPerPage,
+	}
+
+	gdp := &Variable{Concept: "Series", ID: "NY.GDP.MKTP.CD", Value: "GDP (current US$)"}
+	japan := &Variable{Concept: "Country", ID: "JPN", Value: "Japan"}
+
+	type args struct {
+		params *AdvancedParams
+		pages  *PageParams
+	}
+	tests := []struct {
+		name       string
+		args       args
+		want       *PageSummaryWithSourceID
+		want1      []*AdvancedDataValue
+		wantErr    bool
+		wantErrRes *ErrorResponse
+	}{
+		{
+			name: "success with ids and range",
+			args: args{
+				params: &AdvancedParams{
+					SourceID: testutils.TestDefaultSourceID,
+					Dimensions: []*DimensionSelector{
+						{Concept: ConceptCountry, IDs: testutils.TestDefaultCountryIDs},
+						{Concept: ConceptSeries, IDs: []string{testutils.TestDefaultIndicatorID}},
+						{Concept: ConceptTime, Start: "YR" + testutils.TestDefaultDateStart, End: "YR" + testutils.TestDefaultDateEnd},
+					},
+				},
+				pages: defaultPageParams,
+			},
+			want: &PageSummaryWithSourceID{
+				Page:     1,
+				PerPage:  2,
+				SourceID: "2",
+			},
+			want1: []*AdvancedDataValue{
+				{
+					Variables: []*Variable{japan, gdp, {Concept: "Time", ID: "YR2018", Value: "2018"}},
+					Value:     4.95480661999519e+12,
+				},
+				{
+					Variables: []*Variable{japan, gdp, {Concept: "Time", ID: "YR2019", Value: "2019"}},
+					Value:     5.08176954237977e+12,
+				},
+			},
+			wantErr:    false,
+			wantErrRes: nil,
+		},
+		{
+			name: "success with version concept",
+			args: args{
+				params: &AdvancedParams{
+					SourceID: "57",
+					Dimensions: []*DimensionSelector{
+						{Concept: ConceptCountry, IDs: []string{testutils.TestDefaultCountryID}},
+						{Concept: ConceptSeries, IDs: []string{testutils.TestDefaultIndicatorID}},
+						{Concept: ConceptTime, IDs: []string{"YR2020"}},
+						{Concept: ConceptVersion, IDs: []string{"202106"}},
+					},
+				},
+			},
+			want: &PageSummaryWithSourceID{
+				Page:     1,
+				PerPage:  50,
+				SourceID: "57",
+			},
+			want1: []*AdvancedDataValue{
+				{
+					Variables: []*Variable{
+						japan,
+						gdp,
+						{Concept: "Time", ID: "YR2020", Value: "2020"},
+						{Concept: "Version", ID: "202106", Value: "2021 Jun"},
+					},
+					Null: true,
+				},
+			},
+			wantErr:    false,
+			wantErrRes: nil,
+		},
+		{
+			name: "failure because invalid source id",
+			args: args{
+				params: &AdvancedParams{
+					SourceID: testutils.TestInvalidSourceID,
+					Dimensions: []*DimensionSelector{
+						{Concept: ConceptCountry},
+					},
+				},
+			},
+			want:    nil,
+			want1:   nil,
+			wantErr: true,
+			wantErrRes: &ErrorResponse{
+				URL: fmt.Sprintf(
+					"%s%s/sources/%s/country/all?format=json",
+					defaultBaseURL,
+					apiVersion,
+					testutils.TestInvalidSourceID,
+				),
+				Code: 200,
+				Message: []ErrorMessage{
+					{
+						ID:    "120",
+						Key:   "Invalid value",
+						Value: "The provided parameter value is not valid",
+					},
+				},
+			},
+		},
+		{
+			name: "failure because source id is empty",
+			args: args{
+				params: &AdvancedParams{},
+			},
+			want:    nil,
+			want1:   nil,
+			wantErr: true,
+		},
+		{
+			name: "failure because both ids and range are specified",
+			args: args{
+				params: &AdvancedParams{
+					SourceID: testutils.TestDefaultSourceID,
+					Dimensions: []*DimensionSelector{
+						{Concept: ConceptTime, IDs: []string{"YR2018"}, Start: "YR2018", End: "YR2019"},
+					},
+				},
+			},
+			want:    nil,
+			want1:   nil,
+			wantErr: true,
+		},
+		{
+			name: "failure because end of range is empty",
+			args: args{
+				params: &AdvancedParams{
+					SourceID: testutils.TestDefaultSourceID,
+					Dimensions: []*DimensionSelector{
+						{Concept: ConceptTime, Start: "YR2018"},
+					},
+				},
+			},
+			want:    nil,
+			want1:   nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &AdvancedDataService{
+				client: client,
+			}
+			got, got1, err := a.List(tt.args.params, tt.args.pages)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("AdvancedDataService.List() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErrRes != nil {
+				if !reflect.DeepEqual(err, tt.wantErrRes) {
+					t.Errorf("AdvancedDataService.List() err = %v, wantErrRes %v", err, tt.wantErrRes)
+				}
+			}
+			if tt.want != nil && !cmp.Equal(got, tt.want, nil, optIgnoreFields) {
+				t.Errorf("AdvancedDataService.List() got = %+v, want %+v", got, tt.want)
+			}
+			if tt.want1 != nil && !reflect.DeepEqual(got1, tt.want1) {
+				t.Errorf("AdvancedDataService.List() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
+func TestAdvancedDataValue_Variable(t *testing.T) {
+	av := &AdvancedDataValue{
+		Variables: []*Variable{
+			{Concept: "Country", ID: "JPN", Value: "Japan"},
+			{Concept: "Time", ID: "YR2019", Value: "2019"},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		concept string
+		want    *Variable
+	}{
+		{
+			name:    "success",
+			concept: ConceptCountry,
+			want:    av.Variables[0],
+		},
+		{
+			name:    "not found",
+			concept: ConceptVersion,
+			want:    nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := av.Variable(tt.concept); got != tt.want {
+				t.Errorf("AdvancedDataValue.Variable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAdvancedDataValue_JSON(t *testing.T) {
+	tests := []struct {
+		name string
+		av   *AdvancedDataValue
+		want string
+	}{
+		{
+			name: "success",
+			av: &AdvancedDataValue{
+				Variables: []*Variable{{Concept: "Country", ID: "JPN", Value: "Japan"}},
+				Value:     0,
+			},
+			want: `{"variable":[{"concept":"Country","id":"JPN","value":"Japan"}],"value":0}`,
+		},
+		{
+			name: "success with null value",
+			av: &AdvancedDataValue{
+				Variables: []*Variable{{Concept: "Country", ID: "JPN", Value: "Japan"}},
+				Null:      true,
+			},
+			want: `{"variable":[{"concept":"Country","id":"JPN","value":"Japan"}],"value":null}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.av)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("json.Marshal() = %s, want %s", data, tt.want)
+			}
+
+			got := &AdvancedDataValue{}
+			if err := json.Unmarshal(data, got); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.av) {
+				t.Errorf("json.Unmarshal() = %+v, want %+v", got, tt.av)
+			}
+		})
+	}
+}
@@ -0,0 +1,276 @@
+package wbdata
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+const (
+	// AggregationSum sums the values of the members
+	AggregationSum AggregationMethod = "sum"
+	// AggregationMean averages the values of the members
+	AggregationMean AggregationMethod = "mean"
+	// AggregationWeightedMean averages the values of the members weighted by another indicator, e.g. SP.POP.TOTL
+	AggregationWeightedMean AggregationMethod = "weightedmean"
+
+	defaultAggregatePerPage = 1000
+)
+
+type (
+	// AggregationMethod is a method to aggregate the values of the members of a group
+	AggregationMethod string
+
+	// CustomGroup is a group of countries defined by users, e.g. sales regions
+	CustomGroup struct {
+		ID   string
+		Name string
+		// CountryIDs are the ISO3 codes or the IDs of the members
+		CountryIDs []string
+	}
+
+	// AggregateParams contains parameters for CustomGroup.Aggregate and IndicatorValuesService.AggregateGroup
+	AggregateParams struct {
+		Method AggregationMethod
+		// WeightIndicatorID is the indicator of the weights, which is required for AggregationWeightedMean.
+		// If it is specified, the coverage is the share of the weights instead of the members.
+		WeightIndicatorID string
+		// MinCoverage is the minimum coverage from 0 to 1, e.g. 0.66 for 66% of the weights present.
+		// The aggregate of a period under it is null.
+		MinCoverage float64
+		// PerPage is the number of values requested at a time by IndicatorValuesService.AggregateGroup. Defaults to 1000.
+		PerPage int
+	}
+
+	// GroupAggregate is an aggregated value of a group in a period.
+	// Country of it is the group.
+	GroupAggregate struct {
+		IndicatorValue
+		// Coverage is the share of the members, or of the weights, whose values are present
+		Coverage float64 `json:"coverage"`
+		// MissingCountryIDs are the members whose values, or weights for AggregationWeightedMean, are null or missing
+		MissingCountryIDs []string `json:"missing_country_ids"`
+	}
+)
+
+func (m AggregationMethod) String() string {
+	return string(m)
+}
+
+// MarshalJSON encodes an aggregate with coverage and missing countries
+func (ga GroupAggregate) MarshalJSON() ([]byte, error) {
+	type indicatorValue IndicatorValue
+
+	return json.Marshal(struct {
+		indicatorValue
+		Value             *float64 `json:"value"`
+		Coverage          float64  `json:"coverage"`
+		MissingCountryIDs []string `json:"missing_country_ids"`
+	}{
+		indicatorValue:    indicatorValue(ga.IndicatorValue),
+		Value:             ga.nullableValue(),
+		Coverage:          ga.Coverage,
+		MissingCountryIDs: ga.MissingCountryIDs,
+	})
+}
+
+// UnmarshalJSON decodes an aggregate with coverage and missing countries
+func (ga *GroupAggregate) UnmarshalJSON(data []byte) error {
+	if err := ga.IndicatorValue.UnmarshalJSON(data); err != nil {
+		return err
+	}
+
+	aux := struct {
+		Coverage          float64  `json:"coverage"`
+		MissingCountryIDs []string `json:"missing_country_ids"`
+	}{}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	ga.Coverage = aux.Coverage
+	ga.MissingCountryIDs = aux.MissingCountryIDs
+
+	return nil
+}
+
+// AggregateGroup fetches the values of the indicator, and the weights if specified, of the members of the group,
+// and aggregates them
+func (i *IndicatorValuesService) AggregateGroup(
+	group *CustomGroup,
+	indicatorID string,
+	filterParams *FilterParams,
+	params *AggregateParams,
+) ([]*GroupAggregate, error) {
+	if err := params.validate(); err != nil {
+		return nil, err
+	}
+	if group == nil {
+		return nil, errors.New("group should not be nil")
+	}
+	if len(group.CountryIDs) == 0 {
+		return nil, fmt.Errorf("group %s should have members", group.ID)
+	}
+	perPage := params.PerPage
+	if perPage == 0 {
+		perPage = defaultAggregatePerPage
+	}
+
+	indicatorIDs := []string{indicatorID}
+	if params.WeightIndicatorID != "" {
+		indicatorIDs = append(indicatorIDs, params.WeightIndicatorID)
+	}
+	indicatorValues := []*IndicatorValue{}
+	for _, id := range indicatorIDs {
+		ivs, err := i.listAll(uniqueStrings(group.CountryIDs), id, filterParams, perPage)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch %s: %v", id, err)
+		}
+		indicatorValues = append(indicatorValues, ivs...)
+	}
+
+	return group.Aggregate(indicatorID, indicatorValues, params)
+}
+
+// Aggregate aggregates the values of the indicator of the members for each period, ordered by period.
+// indicatorValues should contain the weights if WeightIndicatorID of params is specified.
+// Values of the other countries and indicators are ignored, and duplicated members are counted once.
+func (g *CustomGroup) Aggregate(indicatorID string, indicatorValues []*IndicatorValue, params *AggregateParams) ([]*GroupAggregate, error) {
+	if err := params.validate(); err != nil {
+		return nil, err
+	}
+
+	countryIDs := uniqueStrings(g.CountryIDs)
+	members := map[string]string{}
+	for _, id := range countryIDs {
+		members[id] = id
+	}
+	values := map[string]map[string]*IndicatorValue{}
+	weights := map[string]map[string]*IndicatorValue{}
+	periods := map[string]period{}
+	template := &IndicatorValue{Indicator: IDAndValue{ID: indicatorID}}
+	for _, iv := range indicatorValues {
+		member, ok := members[iv.countryCode()]
+		if !ok {
+			if member, ok = members[iv.Country.ID]; !ok {
+				continue
+			}
+		}
+
+		var target map[string]map[string]*IndicatorValue
+		switch iv.Indicator.ID {
+		case indicatorID:
+			target = values
+			template = iv
+		case params.WeightIndicatorID:
+			target = weights
+		default:
+			continue
+		}
+
+		p, err := parsePeriod(iv.Date)
+		if err != nil {
+			return nil, err
+		}
+		date := p.String()
+		periods[date] = p
+		if target[date] == nil {
+			target[date] = map[string]*IndicatorValue{}
+		}
+		target[date][member] = iv
+	}
+
+	dates := make([]string, 0, len(values))
+	for date := range values {
+		dates = append(dates, date)
+	}
+	sort.Slice(dates, func(i, j int) bool {
+		return periods[dates[i]].before(periods[dates[j]])
+	})
+
+	aggregates := make([]*GroupAggregate, 0, len(dates))
+	for _, date := range dates {
+		ga := aggregateMembers(countryIDs, values[date], weights[date], params)
+		ga.Indicator = template.Indicator
+		ga.Country = IDAndValue{ID: g.ID, Value: g.Name}
+		ga.Countryiso3code = g.ID
+		ga.Date = date
+		ga.Unit = template.Unit
+		aggregates = append(aggregates, ga)
+	}
+
+	return aggregates, nil
+}
+
+// aggregateMembers aggregates the values of the members in a period
+func aggregateMembers(countryIDs []string, values, weights map[string]*IndicatorValue, params *AggregateParams) *GroupAggregate {
+	weighted := params.WeightIndicatorID != ""
+	ga := &GroupAggregate{MissingCountryIDs: []string{}}
+
+	var sum, weightedSum, coveredWeight, totalWeight float64
+	count := 0
+	for _, id := range countryIDs {
+		iv, ok := values[id]
+		ok = ok && !iv.IsNull()
+		w, wok := weights[id]
+		wok = wok && !w.IsNull()
+		if wok {
+			totalWeight += w.Value
+		}
+		if !ok || (params.Method == AggregationWeightedMean && !wok) {
+			ga.MissingCountryIDs = append(ga.MissingCountryIDs, id)
+			continue
+		}
+
+		count++
+		sum += iv.Value
+		if wok {
+			coveredWeight += w.Value
+			weightedSum += iv.Value * w.Value
+		}
+	}
+
+	switch {
+	case weighted && totalWeight > 0:
+		ga.Coverage = coveredWeight / totalWeight
+	case !weighted:
+		ga.Coverage = float64(count) / float64(len(countryIDs))
+	}
+
+	ga.Null = true
+	if count == 0 || ga.Coverage < params.MinCoverage {
+		return ga
+	}
+	switch params.Method {
+	case AggregationSum:
+		ga.Value, ga.Null = sum, false
+	case AggregationMean:
+		ga.Value, ga.Null = sum/float64(count), false
+	case AggregationWeightedMean:
+		if coveredWeight != 0 {
+			ga.Value, ga.Null = weightedSum/coveredWeight, false
+		}
+	}
+
+	return ga
+}
+
+func (params *AggregateParams) validate() error {
+	if params == nil {
+		return errors.New("aggregate params should not be nil")
+	}
+	switch params.Method {
+	case AggregationSum, AggregationMean:
+	case AggregationWeightedMean:
+		if params.WeightIndicatorID == "" {
+			return errors.New("weight indicator ID should be specified for weighted mean")
+		}
+	default:
+		return fmt.Errorf("unsupported aggregation method: %s", params.Method)
+	}
+	if params.MinCoverage < 0 || params.MinCoverage > 1 {
+		return fmt.Errorf("min coverage should be from 0 to 1, but got %v", params.MinCoverage)
+	}
+
+	return nil
+}
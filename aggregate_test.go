@@ -0,0 +1,223 @@
+package wbdata
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jkkitakita/wbdata-go/testutils"
+)
+
+func TestCustomGroup_Aggregate(t *testing.T) {
+	// JPN is duplicated, and counted once
+	group := &CustomGroup{ID: "APAC", Name: "Asia Pacific", CountryIDs: []string{"AUS", "JPN", "KOR", "JPN"}}
+	values := []*IndicatorValue{
+		newTestValue("NY.GDP.MKTP.CD", "AUS", "2019", 1),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2019", 5),
+		newTestValue("NY.GDP.MKTP.CD", "KOR", "2019", 2),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2018", 4),
+		newTestNullValue("NY.GDP.MKTP.CD", "KOR", "2018"),
+		newTestValue("NY.GDP.MKTP.CD", "USA", "2019", 21),
+		newTestValue("SP.POP.TOTL", "AUS", "2019", 25),
+		newTestValue("SP.POP.TOTL", "JPN", "2019", 125),
+		newTestValue("SP.POP.TOTL", "KOR", "2019", 50),
+		newTestValue("SP.POP.TOTL", "AUS", "2018", 25),
+		newTestValue("SP.POP.TOTL", "JPN", "2018", 125),
+		newTestValue("SP.POP.TOTL", "KOR", "2018", 50),
+	}
+
+	type want struct {
+		date     string
+		value    float64
+		null     bool
+		coverage float64
+		missing  []string
+	}
+	tests := []struct {
+		name    string
+		params  *AggregateParams
+		want    []want
+		wantErr bool
+	}{
+		{
+			name:   "sum",
+			params: &AggregateParams{Method: AggregationSum},
+			want: []want{
+				{date: "2018", value: 4, coverage: 1.0 / 3, missing: []string{"AUS", "KOR"}},
+				{date: "2019", value: 8, coverage: 1, missing: []string{}},
+			},
+		},
+		{
+			name:   "mean with min coverage",
+			params: &AggregateParams{Method: AggregationMean, MinCoverage: 0.5},
+			want: []want{
+				{date: "2018", null: true, coverage: 1.0 / 3, missing: []string{"AUS", "KOR"}},
+				{date: "2019", value: 8.0 / 3, coverage: 1, missing: []string{}},
+			},
+		},
+		{
+			name:   "weighted mean with min coverage of weights",
+			params: &AggregateParams{Method: AggregationWeightedMean, WeightIndicatorID: "SP.POP.TOTL", MinCoverage: 0.6},
+			want: []want{
+				{date: "2018", value: 4, coverage: 0.625, missing: []string{"AUS", "KOR"}},
+				{date: "2019", value: (1*25 + 5*125 + 2*50) / 200.0, coverage: 1, missing: []string{}},
+			},
+		},
+		{
+			name:    "failure because of a weighted mean without weights",
+			params:  &AggregateParams{Method: AggregationWeightedMean},
+			wantErr: true,
+		},
+		{
+			name:    "failure because of an unsupported method",
+			params:  &AggregateParams{Method: "invalid_method"},
+			wantErr: true,
+		},
+		{
+			name:    "failure because of min coverage over 1",
+			params:  &AggregateParams{Method: AggregationSum, MinCoverage: 66},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := group.Aggregate("NY.GDP.MKTP.CD", values, tt.params)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CustomGroup.Aggregate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("CustomGroup.Aggregate() len = %d, want %d", len(got), len(tt.want))
+			}
+			for i, w := range tt.want {
+				ga := got[i]
+				if ga.Date != w.date || ga.IsNull() != w.null || math.Abs(ga.Value-w.value) > 1e-9 {
+					t.Errorf("CustomGroup.Aggregate()[%d] = %v %v (null %v), want %+v", i, ga.Date, ga.Value, ga.IsNull(), w)
+				}
+				if math.Abs(ga.Coverage-w.coverage) > 1e-9 || !reflect.DeepEqual(ga.MissingCountryIDs, w.missing) {
+					t.Errorf("CustomGroup.Aggregate()[%d] = %v %v, want %+v", i, ga.Coverage, ga.MissingCountryIDs, w)
+				}
+				if ga.Country.ID != "APAC" || ga.Countryiso3code != "APAC" || ga.Indicator.ID != "NY.GDP.MKTP.CD" {
+					t.Errorf("CustomGroup.Aggregate()[%d] = %+v", i, ga.IndicatorValue)
+				}
+			}
+		})
+	}
+}
+
+func TestIndicatorValuesService_AggregateGroup(t *testing.T) {
+	client, save := NewTestClient(t, *update)
+	defer save()
+
+	i := &IndicatorValuesService{
+		client: client,
+	}
+	// the duplicated member is fetched once
+	countryIDs := append(append([]string{}, testutils.TestDefaultCountryIDs...), testutils.TestDefaultCountryIDs[0])
+	group := &CustomGroup{ID: "JPUS", Name: "Japan and United States", CountryIDs: countryIDs}
+	filterParams := &FilterParams{
+		FilterParamsType: FilterParamsDateRange,
+		DateParam: &DateParam{
+			DateRange: &DateRange{
+				Start: testutils.TestDefaultDateStart,
+				End:   testutils.TestDefaultDateEnd,
+			},
+		},
+	}
+	params := &AggregateParams{
+		Method:            AggregationWeightedMean,
+		WeightIndicatorID: "SP.POP.TOTL",
+		MinCoverage:       0.66,
+		PerPage:           testutils.TestDefaultPerPage,
+	}
+
+	got, err := i.AggregateGroup(group, "SP.DYN.LE00.IN", filterParams, params)
+	if err != nil {
+		t.Fatalf("IndicatorValuesService.AggregateGroup() error = %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("IndicatorValuesService.AggregateGroup() len = %d, want 2", len(got))
+	}
+	// the life expectancy of USA is null in 2018, and Japan has 28% of the population
+	if y2018 := got[0]; y2018.Date != "2018" || !y2018.IsNull() || !reflect.DeepEqual(y2018.MissingCountryIDs, []string{"USA"}) {
+		t.Errorf("IndicatorValuesService.AggregateGroup()[0] = %+v", y2018)
+	}
+	want := (84.3563414634146*126264931 + 78.7878048780488*328239523) / (126264931 + 328239523)
+	if y2019 := got[1]; y2019.Date != "2019" || y2019.IsNull() || math.Abs(y2019.Value-want) > 1e-9 || y2019.Coverage != 1 {
+		t.Errorf("IndicatorValuesService.AggregateGroup()[1] = %+v, want %v", y2019, want)
+	}
+	if got[1].Indicator.Value != "Life expectancy at birth, total (years)" {
+		t.Errorf("IndicatorValuesService.AggregateGroup()[1] Indicator = %+v", got[1].Indicator)
+	}
+
+	if _, err := i.AggregateGroup(group, testutils.TestInvalidIndicatorID, filterParams, params); err == nil {
+		t.Errorf("IndicatorValuesService.AggregateGroup() error = nil, want error")
+	}
+	if _, err := i.AggregateGroup(&CustomGroup{ID: "EMPTY"}, "SP.DYN.LE00.IN", filterParams, params); err == nil {
+		t.Errorf("IndicatorValuesService.AggregateGroup() error = nil, want error")
+	}
+	if _, err := i.AggregateGroup(nil, "SP.DYN.LE00.IN", filterParams, params); err == nil {
+		t.Errorf("IndicatorValuesService.AggregateGroup() error = nil, want error")
+	}
+}
+
+func TestGroupAggregate_JSON(t *testing.T) {
+	tests := []struct {
+		name string
+		ga   *GroupAggregate
+		want string
+	}{
+		{
+			name: "success",
+			ga: &GroupAggregate{
+				IndicatorValue: IndicatorValue{
+					Indicator: IDAndValue{ID: "SP.DYN.LE00.IN", Value: "Life expectancy at birth, total (years)"},
+					Country:   IDAndValue{ID: "JPNUSA", Value: "Japan and USA"},
+					Date:      "2019",
+					Value:     80.3,
+				},
+				Coverage:          1,
+				MissingCountryIDs: []string{},
+			},
+			want: `"value":80.3,"coverage":1,"missing_country_ids":[]`,
+		},
+		{
+			name: "success with null value",
+			ga: &GroupAggregate{
+				IndicatorValue: IndicatorValue{
+					Indicator: IDAndValue{ID: "SP.DYN.LE00.IN", Value: "Life expectancy at birth, total (years)"},
+					Country:   IDAndValue{ID: "JPNUSA", Value: "Japan and USA"},
+					Date:      "2018",
+					Null:      true,
+				},
+				Coverage:          0.28,
+				MissingCountryIDs: []string{"USA"},
+			},
+			want: `"value":null,"coverage":0.28,"missing_country_ids":["USA"]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.ga)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			if !strings.Contains(string(data), tt.want) {
+				t.Errorf("json.Marshal() = %s, want %s", data, tt.want)
+			}
+
+			got := &GroupAggregate{}
+			if err := json.Unmarshal(data, got); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.ga) {
+				t.Errorf("json.Unmarshal() = %+v, want %+v", got, tt.ga)
+			}
+		})
+	}
+}
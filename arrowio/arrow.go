@@ -0,0 +1,159 @@
+// Package arrowio converts indicator values of wbdata to Apache Arrow record batches and IPC streams.
+// It is a separate module so that the wbdata package does not depend on Arrow.
+package arrowio
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+
+	"github.com/jkkitakita/wbdata-go"
+)
+
+const (
+	arrowFieldIndicatorID     = "indicator_id"
+	arrowFieldIndicatorName   = "indicator_name"
+	arrowFieldCountryID       = "country_id"
+	arrowFieldCountryName     = "country_name"
+	arrowFieldCountryiso3code = "countryiso3code"
+	arrowFieldDate            = "date"
+	arrowFieldValue           = "value"
+	arrowFieldUnit            = "unit"
+	arrowFieldObsStatus       = "obs_status"
+	arrowFieldDecimal         = "decimal"
+)
+
+type (
+	// IndicatorValuesStreamWriter writes indicator values as Arrow IPC stream record batches
+	IndicatorValuesStreamWriter struct {
+		mem    memory.Allocator
+		writer *ipc.Writer
+	}
+)
+
+// IndicatorValueSchema is the Arrow schema of record batches built from indicator values.
+// Only the value column is nullable.
+var IndicatorValueSchema = arrow.NewSchema(
+	[]arrow.Field{
+		{Name: arrowFieldIndicatorID, Type: arrow.BinaryTypes.String},
+		{Name: arrowFieldIndicatorName, Type: arrow.BinaryTypes.String},
+		{Name: arrowFieldCountryID, Type: arrow.BinaryTypes.String},
+		{Name: arrowFieldCountryName, Type: arrow.BinaryTypes.String},
+		{Name: arrowFieldCountryiso3code, Type: arrow.BinaryTypes.String},
+		{Name: arrowFieldDate, Type: arrow.BinaryTypes.String},
+		{Name: arrowFieldValue, Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+		{Name: arrowFieldUnit, Type: arrow.BinaryTypes.String},
+		{Name: arrowFieldObsStatus, Type: arrow.BinaryTypes.String},
+		{Name: arrowFieldDecimal, Type: arrow.PrimitiveTypes.Int32},
+	},
+	nil,
+)
+
+// NewIndicatorValuesRecord returns an Arrow record batch of indicator values with IndicatorValueSchema.
+// If mem is nil, memory.DefaultAllocator is used. The caller must release the record batch.
+func NewIndicatorValuesRecord(mem memory.Allocator, indicatorValues []*wbdata.IndicatorValue) arrow.RecordBatch {
+	if mem == nil {
+		mem = memory.DefaultAllocator
+	}
+
+	b := array.NewRecordBuilder(mem, IndicatorValueSchema)
+	defer b.Release()
+	b.Reserve(len(indicatorValues))
+
+	for _, iv := range indicatorValues {
+		b.Field(0).(*array.StringBuilder).Append(iv.Indicator.ID)
+		b.Field(1).(*array.StringBuilder).Append(iv.Indicator.Value)
+		b.Field(2).(*array.StringBuilder).Append(iv.Country.ID)
+		b.Field(3).(*array.StringBuilder).Append(iv.Country.Value)
+		b.Field(4).(*array.StringBuilder).Append(iv.Countryiso3code)
+		b.Field(5).(*array.StringBuilder).Append(iv.Date)
+		if iv.IsNull() {
+			b.Field(6).AppendNull()
+		} else {
+			b.Field(6).(*array.Float64Builder).Append(iv.Value)
+		}
+		b.Field(7).(*array.StringBuilder).Append(iv.Unit)
+		b.Field(8).(*array.StringBuilder).Append(iv.ObsStatus)
+		b.Field(9).(*array.Int32Builder).Append(iv.Decimal)
+	}
+
+	return b.NewRecordBatch()
+}
+
+// IndicatorValuesFromRecord returns indicator values from an Arrow record batch with IndicatorValueSchema
+func IndicatorValuesFromRecord(rec arrow.RecordBatch) ([]*wbdata.IndicatorValue, error) {
+	if !rec.Schema().Equal(IndicatorValueSchema) {
+		return nil, fmt.Errorf("record schema does not match IndicatorValueSchema. schema: %v", rec.Schema())
+	}
+
+	indicatorIDs := rec.Column(0).(*array.String)
+	indicatorNames := rec.Column(1).(*array.String)
+	countryIDs := rec.Column(2).(*array.String)
+	countryNames := rec.Column(3).(*array.String)
+	countryiso3codes := rec.Column(4).(*array.String)
+	dates := rec.Column(5).(*array.String)
+	values := rec.Column(6).(*array.Float64)
+	units := rec.Column(7).(*array.String)
+	obsStatuses := rec.Column(8).(*array.String)
+	decimals := rec.Column(9).(*array.Int32)
+
+	indicatorValues := make([]*wbdata.IndicatorValue, rec.NumRows())
+	for i := range indicatorValues {
+		indicatorValues[i] = &wbdata.IndicatorValue{
+			Indicator: wbdata.IDAndValue{
+				ID:    indicatorIDs.Value(i),
+				Value: indicatorNames.Value(i),
+			},
+			Country: wbdata.IDAndValue{
+				ID:    countryIDs.Value(i),
+				Value: countryNames.Value(i),
+			},
+			Countryiso3code: countryiso3codes.Value(i),
+			Date:            dates.Value(i),
+			Value:           values.Value(i),
+			Unit:            units.Value(i),
+			ObsStatus:       obsStatuses.Value(i),
+			Decimal:         decimals.Value(i),
+			Null:            values.IsNull(i),
+		}
+		if indicatorValues[i].Null {
+			indicatorValues[i].Value = 0
+		}
+	}
+
+	return indicatorValues, nil
+}
+
+// NewIndicatorValuesStreamWriter returns a new writer of an Arrow IPC stream with IndicatorValueSchema.
+// If mem is nil, memory.DefaultAllocator is used.
+func NewIndicatorValuesStreamWriter(w io.Writer, mem memory.Allocator) *IndicatorValuesStreamWriter {
+	if mem == nil {
+		mem = memory.DefaultAllocator
+	}
+
+	return &IndicatorValuesStreamWriter{
+		mem: mem,
+		writer: ipc.NewWriter(
+			w,
+			ipc.WithSchema(IndicatorValueSchema),
+			ipc.WithAllocator(mem),
+		),
+	}
+}
+
+// Write writes indicator values, e.g. a page of an API response, as a record batch
+func (w *IndicatorValuesStreamWriter) Write(indicatorValues []*wbdata.IndicatorValue) error {
+	rec := NewIndicatorValuesRecord(w.mem, indicatorValues)
+	defer rec.Release()
+
+	return w.writer.Write(rec)
+}
+
+// Close writes the end of the stream. It does not close the underlying writer.
+func (w *IndicatorValuesStreamWriter) Close() error {
+	return w.writer.Close()
+}
@@ -0,0 +1,114 @@
+package arrowio
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+
+	"github.com/jkkitakita/wbdata-go"
+)
+
+func newTestIndicatorValues() []*wbdata.IndicatorValue {
+	return []*wbdata.IndicatorValue{
+		{
+			Indicator: wbdata.IDAndValue{
+				ID:    "NY.GDP.MKTP.CD",
+				Value: "GDP (current US$)",
+			},
+			Country: wbdata.IDAndValue{
+				ID:    "JP",
+				Value: "Japan",
+			},
+			Countryiso3code: "JPN",
+			Date:            "2020",
+			Null:            true,
+		},
+		{
+			Indicator: wbdata.IDAndValue{
+				ID:    "NY.GDP.MKTP.CD",
+				Value: "GDP (current US$)",
+			},
+			Country: wbdata.IDAndValue{
+				ID:    "JP",
+				Value: "Japan",
+			},
+			Countryiso3code: "JPN",
+			Date:            "2019",
+			Value:           5.08176954237977e+12,
+		},
+	}
+}
+
+func TestNewIndicatorValuesRecord(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	indicatorValues := newTestIndicatorValues()
+	rec := NewIndicatorValuesRecord(mem, indicatorValues)
+	defer rec.Release()
+
+	if rec.NumRows() != int64(len(indicatorValues)) {
+		t.Errorf("NewIndicatorValuesRecord() rows = %d, want %d", rec.NumRows(), len(indicatorValues))
+	}
+	values := rec.Column(6).(*array.Float64)
+	if !values.IsNull(0) {
+		t.Errorf("NewIndicatorValuesRecord() value[0] should be null")
+	}
+	if values.IsNull(1) || values.Value(1) != indicatorValues[1].Value {
+		t.Errorf("NewIndicatorValuesRecord() value[1] = %v, want %v", values.Value(1), indicatorValues[1].Value)
+	}
+
+	got, err := IndicatorValuesFromRecord(rec)
+	if err != nil {
+		t.Fatalf("IndicatorValuesFromRecord() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, indicatorValues) {
+		t.Errorf("IndicatorValuesFromRecord() = %v, want %v", got, indicatorValues)
+	}
+}
+
+func TestIndicatorValuesStreamWriter(t *testing.T) {
+	indicatorValues := newTestIndicatorValues()
+
+	var buf bytes.Buffer
+	w := NewIndicatorValuesStreamWriter(&buf, nil)
+	for _, page := range [][]*wbdata.IndicatorValue{indicatorValues[:1], indicatorValues[1:]} {
+		if err := w.Write(page); err != nil {
+			t.Fatalf("IndicatorValuesStreamWriter.Write() error = %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("IndicatorValuesStreamWriter.Close() error = %v", err)
+	}
+
+	r, err := ipc.NewReader(&buf)
+	if err != nil {
+		t.Fatalf("ipc.NewReader() error = %v", err)
+	}
+	defer r.Release()
+
+	got := []*wbdata.IndicatorValue{}
+	batches := 0
+	for r.Next() {
+		batches++
+		values, err := IndicatorValuesFromRecord(r.RecordBatch())
+		if err != nil {
+			t.Fatalf("IndicatorValuesFromRecord() error = %v", err)
+		}
+		got = append(got, values...)
+	}
+	if err := r.Err(); err != nil {
+		t.Fatalf("ipc.Reader.Next() error = %v", err)
+	}
+
+	if batches != 2 {
+		t.Errorf("IndicatorValuesStreamWriter batches = %d, want %d", batches, 2)
+	}
+	if !reflect.DeepEqual(got, indicatorValues) {
+		t.Errorf("IndicatorValuesStreamWriter got = %v, want %v", got, indicatorValues)
+	}
+}
@@ -0,0 +1,311 @@
+package wbdata
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+)
+
+const (
+	// BulkDownloadFormatCSV is the bulk download format for zipped CSV
+	BulkDownloadFormatCSV BulkDownloadFormat = "csv"
+	// BulkDownloadFormatXML is the bulk download format for zipped XML
+	BulkDownloadFormatXML BulkDownloadFormat = "xml"
+	// BulkDownloadFormatExcel is the bulk download format for zipped Excel
+	BulkDownloadFormatExcel BulkDownloadFormat = "excel"
+
+	bulkDataFilePrefix              = "API_"
+	bulkCountryMetadataFilePrefix   = "Metadata_Country_"
+	bulkIndicatorMetadataFilePrefix = "Metadata_Indicator_"
+	bulkLastUpdatedKey              = "Last Updated Date"
+	bulkDataHeaderKey               = "Country Name"
+	bulkDataFirstDateColumn         = 4
+	bulkByteOrderMark               = "\ufeff"
+	bulkCSVExtension                = ".csv"
+)
+
+type (
+	// BulkDownloadFormat is the format of bulk download archives
+	BulkDownloadFormat string
+
+	// BulkDownloadParams contains parameters for BulkDownload
+	BulkDownloadParams struct {
+		// TempDir is a directory to save the archive to. If it is empty, the archive is kept in memory.
+		TempDir string
+	}
+
+	// BulkData contains indicator values and metadata parsed from a bulk download archive
+	BulkData struct {
+		LastUpdated string
+		// IndicatorValues have the ISO3 code as Countryiso3code and the name as Country.Value.
+		// Country.ID is empty, because the archive has no ISO2 codes of countries.
+		IndicatorValues []*IndicatorValue
+		Countries       []*Country
+		Indicators      []*Indicator
+	}
+)
+
+func (f BulkDownloadFormat) String() string {
+	return string(f)
+}
+
+// DownloadArchive writes the zip archive of the whole indicator dataset in format to w.
+// Only archives of BulkDownloadFormatCSV can be parsed by ParseBulkArchive.
+func (i *IndicatorValuesService) DownloadArchive(indicatorID string, format BulkDownloadFormat, w io.Writer) error {
+	switch format {
+	case BulkDownloadFormatCSV, BulkDownloadFormatXML, BulkDownloadFormatExcel:
+	default:
+		return fmt.Errorf("unsupported bulk download format: %s", format)
+	}
+
+	path := fmt.Sprintf("indicator/%s", indicatorID)
+	req, err := i.client.NewRequest("GET", path, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	params := req.URL.Query()
+	params.Del(`format`)
+	params.Set(`downloadformat`, format.String())
+	req.URL.RawQuery = params.Encode()
+
+	return i.client.download(req, w)
+}
+
+// BulkDownload downloads the zipped CSV archive of the whole indicator dataset and parses it.
+// It is much faster than paging through List for all countries and dates.
+func (i *IndicatorValuesService) BulkDownload(indicatorID string, params *BulkDownloadParams) (*BulkData, error) {
+	if params == nil || params.TempDir == "" {
+		buf := &bytes.Buffer{}
+		if err := i.DownloadArchive(indicatorID, BulkDownloadFormatCSV, buf); err != nil {
+			return nil, err
+		}
+		return ParseBulkArchive(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	}
+
+	f, err := ioutil.TempFile(params.TempDir, "wbdata-*.zip")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	if err := i.DownloadArchive(indicatorID, BulkDownloadFormatCSV, f); err != nil {
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseBulkArchive(f, info.Size())
+}
+
+// ParseBulkArchive parses a zipped CSV archive of a bulk download.
+// It returns an error for archives of the other formats.
+func ParseBulkArchive(r io.ReaderAt, size int64) (*BulkData, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bulk download archive: %v", err)
+	}
+
+	data := &BulkData{}
+	var countryMetadata [][]string
+	foundData := false
+	for _, f := range zr.File {
+		name := path.Base(f.Name)
+		if !strings.EqualFold(path.Ext(name), bulkCSVExtension) {
+			if strings.HasPrefix(name, bulkDataFilePrefix) {
+				return nil, fmt.Errorf("unsupported data file %s: only zipped CSV archives can be parsed", name)
+			}
+			continue
+		}
+		records, err := readBulkCSV(f)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case strings.HasPrefix(name, bulkCountryMetadataFilePrefix):
+			countryMetadata = records
+		case strings.HasPrefix(name, bulkIndicatorMetadataFilePrefix):
+			data.Indicators = parseBulkIndicators(records)
+		case strings.HasPrefix(name, bulkDataFilePrefix):
+			if err := data.parseIndicatorValues(records); err != nil {
+				return nil, fmt.Errorf("failed to parse %s: %v", name, err)
+			}
+			foundData = true
+		}
+	}
+	if !foundData {
+		return nil, errors.New("bulk download archive has no data file")
+	}
+
+	data.Countries = parseBulkCountries(countryMetadata, data.IndicatorValues)
+
+	return data, nil
+}
+
+func readBulkCSV(f *zip.File) ([][]string, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	b, err := ioutil.ReadAll(rc)
+	if err != nil {
+		return nil, err
+	}
+
+	cr := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(b, []byte(bulkByteOrderMark))))
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %v", f.Name, err)
+	}
+
+	return records, nil
+}
+
+// parseIndicatorValues parses the data file, which has a preamble, a header with dates and a row per country.
+// Values are ordered newest first in the same way as the API.
+func (data *BulkData) parseIndicatorValues(records [][]string) error {
+	var header []string
+	for i, record := range records {
+		if len(record) == 0 {
+			continue
+		}
+		if header == nil {
+			switch record[0] {
+			case bulkLastUpdatedKey:
+				if len(record) > 1 {
+					data.LastUpdated = record[1]
+				}
+			case bulkDataHeaderKey:
+				header = record
+			}
+			continue
+		}
+		if len(record) < bulkDataFirstDateColumn {
+			return fmt.Errorf("line %d has too few fields", i+1)
+		}
+
+		for j := len(header) - 1; j >= bulkDataFirstDateColumn; j-- {
+			date := strings.TrimSpace(header[j])
+			if date == "" {
+				continue
+			}
+			iv := &IndicatorValue{
+				Indicator: IDAndValue{
+					ID:    record[3],
+					Value: record[2],
+				},
+				Country: IDAndValue{
+					Value: record[0],
+				},
+				Countryiso3code: record[1],
+				Date:            date,
+				Null:            true,
+			}
+			if j < len(record) && record[j] != "" {
+				v, err := strconv.ParseFloat(record[j], 64)
+				if err != nil {
+					return fmt.Errorf("line %d: %v", i+1, err)
+				}
+				iv.Value = v
+				iv.Null = false
+			}
+			data.IndicatorValues = append(data.IndicatorValues, iv)
+		}
+	}
+	if header == nil {
+		return errors.New("header is not found")
+	}
+
+	return nil
+}
+
+// parseBulkCountries parses the country metadata file with the country names from the indicator values
+func parseBulkCountries(records [][]string, indicatorValues []*IndicatorValue) []*Country {
+	names := map[string]string{}
+	for _, iv := range indicatorValues {
+		names[iv.Countryiso3code] = iv.Country.Value
+	}
+
+	countries := []*Country{}
+	columns := bulkColumns(records)
+	for _, record := range bulkRows(records) {
+		id := bulkField(record, columns, "Country Code")
+		if id == "" {
+			continue
+		}
+		countries = append(countries, &Country{
+			ID:          id,
+			Name:        names[id],
+			Region:      CountryRegion{Value: bulkField(record, columns, "Region")},
+			IncomeLevel: IncomeLevel{Value: bulkField(record, columns, "IncomeGroup")},
+		})
+	}
+
+	return countries
+}
+
+func parseBulkIndicators(records [][]string) []*Indicator {
+	indicators := []*Indicator{}
+	columns := bulkColumns(records)
+	for _, record := range bulkRows(records) {
+		id := bulkField(record, columns, "INDICATOR_CODE")
+		if id == "" {
+			continue
+		}
+		indicators = append(indicators, &Indicator{
+			ID:                 id,
+			Name:               bulkField(record, columns, "INDICATOR_NAME"),
+			SourceNote:         bulkField(record, columns, "SOURCE_NOTE"),
+			SourceOrganization: bulkField(record, columns, "SOURCE_ORGANIZATION"),
+		})
+	}
+
+	return indicators
+}
+
+// bulkColumns returns column indexes by the names in the first record
+func bulkColumns(records [][]string) map[string]int {
+	columns := map[string]int{}
+	if len(records) == 0 {
+		return columns
+	}
+	for i, name := range records[0] {
+		columns[strings.TrimSpace(name)] = i
+	}
+
+	return columns
+}
+
+// bulkRows returns records without the header
+func bulkRows(records [][]string) [][]string {
+	if len(records) == 0 {
+		return nil
+	}
+
+	return records[1:]
+}
+
+func bulkField(record []string, columns map[string]int, name string) string {
+	i, ok := columns[name]
+	if !ok || i >= len(record) {
+		return ""
+	}
+
+	return strings.TrimSpace(record[i])
+}
@@ -0,0 +1,162 @@
+package wbdata
+
+import (
+	"archive/zip"
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/jkkitakita/wbdata-go/testutils"
+)
+
+const testBulkArchive = "API_NY.GDP.MKTP.CD_DS2_en_csv_v2_2445719.zip"
+
+func newTestBulkClient() (*Client, func()) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if r.URL.Path != "/v2/indicator/"+testutils.TestDefaultIndicatorID ||
+			query.Get("downloadformat") != BulkDownloadFormatCSV.String() ||
+			query.Get("format") != "" {
+			w.Write([]byte(`<?xml version="1.0" encoding="utf-8"?><wb:error></wb:error>`)) //nolint:errcheck
+			return
+		}
+		http.ServeFile(w, r, filepath.Join("testdata", "bulk", testBulkArchive))
+	}))
+
+	client := NewClient(server.Client())
+	client.BaseURL, _ = url.Parse(server.URL + "/" + apiVersion + "/")
+
+	return client, server.Close
+}
+
+func TestIndicatorValuesService_BulkDownload(t *testing.T) {
+	client, closeServer := newTestBulkClient()
+	defer closeServer()
+
+	type args struct {
+		indicatorID string
+		params      *BulkDownloadParams
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "success",
+			args: args{
+				indicatorID: testutils.TestDefaultIndicatorID,
+			},
+			wantErr: false,
+		},
+		{
+			name: "success with temp dir",
+			args: args{
+				indicatorID: testutils.TestDefaultIndicatorID,
+				params: &BulkDownloadParams{
+					TempDir: t.TempDir(),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "failure because invalid indicator id",
+			args: args{
+				indicatorID: testutils.TestInvalidIndicatorID,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := &IndicatorValuesService{
+				client: client,
+			}
+			got, err := i.BulkDownload(tt.args.indicatorID, tt.args.params)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IndicatorValuesService.BulkDownload() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if got.LastUpdated != "2021-06-30" {
+				t.Errorf("IndicatorValuesService.BulkDownload() LastUpdated = %v, want %v", got.LastUpdated, "2021-06-30")
+			}
+			if len(got.IndicatorValues) != 6 {
+				t.Fatalf("IndicatorValuesService.BulkDownload() len(IndicatorValues) = %d, want %d", len(got.IndicatorValues), 6)
+			}
+			wantNull := got.IndicatorValues[3]
+			if wantNull.Countryiso3code != "JPN" || wantNull.Date != "2020" || !wantNull.IsNull() {
+				t.Errorf("IndicatorValuesService.BulkDownload() IndicatorValues[3] = %+v, want null value of JPN in 2020", wantNull)
+			}
+			want := &IndicatorValue{
+				Indicator: IDAndValue{
+					ID:    "NY.GDP.MKTP.CD",
+					Value: "GDP (current US$)",
+				},
+				Country: IDAndValue{
+					Value: "Japan",
+				},
+				Countryiso3code: "JPN",
+				Date:            "2019",
+				Value:           5.08176954237977e+12,
+			}
+			if !reflect.DeepEqual(got.IndicatorValues[4], want) {
+				t.Errorf("IndicatorValuesService.BulkDownload() IndicatorValues[4] = %+v, want %+v", got.IndicatorValues[4], want)
+			}
+
+			wantCountries := []*Country{
+				{ID: "ARB", Name: "Arab World"},
+				{
+					ID:          "JPN",
+					Name:        "Japan",
+					Region:      CountryRegion{Value: "East Asia & Pacific"},
+					IncomeLevel: IncomeLevel{Value: "High income"},
+				},
+			}
+			if !reflect.DeepEqual(got.Countries, wantCountries) {
+				t.Errorf("IndicatorValuesService.BulkDownload() Countries = %+v, want %+v", got.Countries, wantCountries)
+			}
+			if len(got.Indicators) != 1 || got.Indicators[0].SourceOrganization == "" {
+				t.Errorf("IndicatorValuesService.BulkDownload() Indicators = %+v", got.Indicators)
+			}
+		})
+	}
+}
+
+func TestIndicatorValuesService_DownloadArchive(t *testing.T) {
+	client, closeServer := newTestBulkClient()
+	defer closeServer()
+
+	i := &IndicatorValuesService{
+		client: client,
+	}
+	buf := &bytes.Buffer{}
+	if err := i.DownloadArchive(testutils.TestDefaultIndicatorID, BulkDownloadFormatCSV, buf); err != nil || buf.Len() == 0 {
+		t.Errorf("IndicatorValuesService.DownloadArchive() error = %v, len = %d", err, buf.Len())
+	}
+	if err := i.DownloadArchive(testutils.TestDefaultIndicatorID, "invalid_format", &bytes.Buffer{}); err == nil {
+		t.Errorf("IndicatorValuesService.DownloadArchive() error = nil, want error")
+	}
+}
+
+func TestParseBulkArchive(t *testing.T) {
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	if _, err := zw.Create("API_NY.GDP.MKTP.CD_DS2_en_xml_v2_2445719.xml"); err != nil {
+		t.Fatalf("zip.Writer.Create() error = %v", err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("zip.Writer.Close() error = %v", err)
+	}
+
+	if _, err := ParseBulkArchive(bytes.NewReader(buf.Bytes()), int64(buf.Len())); err == nil {
+		t.Errorf("ParseBulkArchive() error = nil, want error")
+	}
+}
@@ -27,7 +27,26 @@ type (
 		RegionID      string
 		IncomeLevelID string
 		LendingTypeID string
+		// Classification filters countries of a page to economies or aggregates.
+		// The API doesn't support it, so the countries are filtered after paging and PageSummary is of the unfiltered page,
+		// i.e. a page may have fewer countries than PerPage, and Total counts both economies and aggregates.
+		Classification CountryClassification
 	}
+
+	// CountryClassification is the classification of a country, an economy or an aggregate
+	CountryClassification string
+)
+
+const (
+	// CountryClassificationAll is the classification of both economies and aggregates
+	CountryClassificationAll CountryClassification = ""
+	// CountryClassificationEconomies is the classification of economies, e.g. Japan
+	CountryClassificationEconomies CountryClassification = "economies"
+	// CountryClassificationAggregates is the classification of aggregates, e.g. Arab World and High income
+	CountryClassificationAggregates CountryClassification = "aggregates"
+
+	// aggregateRegionID is Region.ID of aggregates in the Countries API
+	aggregateRegionID = "NA"
 )
 
 // List returns summary and countries with params
@@ -35,6 +54,10 @@ func (c *CountriesService) List(
 	params *ListCountryParams,
 	pages *PageParams,
 ) (*PageSummary, []*Country, error) {
+	if err := params.validate(); err != nil {
+		return nil, nil, err
+	}
+
 	summary := &PageSummary{}
 	countries := []*Country{}
 	queryParams := params.toQueryParams()
@@ -52,7 +75,7 @@ func (c *CountriesService) List(
 		return nil, nil, err
 	}
 
-	return summary, countries, nil
+	return summary, params.filter(countries), nil
 }
 
 // Get returns summary and a country
@@ -84,3 +107,38 @@ func (params *ListCountryParams) toQueryParams() map[string]string {
 		"lendingtype": params.LendingTypeID,
 	}
 }
+
+// validate returns an error if the classification is not one of the declared constants
+func (params *ListCountryParams) validate() error {
+	if params == nil {
+		return nil
+	}
+	switch params.Classification {
+	case CountryClassificationAll, CountryClassificationEconomies, CountryClassificationAggregates:
+		return nil
+	default:
+		return fmt.Errorf("unsupported country classification: %s", params.Classification)
+	}
+}
+
+// filter returns countries of the classification
+func (params *ListCountryParams) filter(countries []*Country) []*Country {
+	if params == nil || params.Classification == CountryClassificationAll {
+		return countries
+	}
+
+	filtered := []*Country{}
+	for _, country := range countries {
+		if country.IsAggregate() == (params.Classification == CountryClassificationAggregates) {
+			filtered = append(filtered, country)
+		}
+	}
+
+	return filtered
+}
+
+// IsAggregate reports whether the country is an aggregate, e.g. Arab World and High income.
+// The API returns NA as Region.ID of aggregates.
+func (c *Country) IsAggregate() bool {
+	return c.Region.ID == aggregateRegionID
+}
@@ -404,6 +404,94 @@ func TestCountriesService_List(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "success with economies",
+			args: args{
+				params: &ListCountryParams{
+					Classification: CountryClassificationEconomies,
+				},
+				pages: &PageParams{
+					Page:    testutils.TestDefaultPage,
+					PerPage: 4,
+				},
+			},
+			want: &PageSummary{
+				Page:    intOrString(testutils.TestDefaultPage),
+				PerPage: 4,
+			},
+			want1: []*Country{
+				{
+					ID:          "ABW",
+					Name:        "Aruba",
+					CapitalCity: "Oranjestad",
+					Iso2Code:    "AW",
+					Longitude:   "-70.0167",
+					Latitude:    "12.5167",
+					Region:      CountryRegion{ID: "LCN", Iso2Code: "ZJ", Value: "Latin America & Caribbean "},
+					IncomeLevel: IncomeLevel{ID: "HIC", Iso2Code: "XD", Value: "High income"},
+					LendingType: LendingType{ID: "LNX", Iso2Code: "XX", Value: "Not classified"},
+				},
+				{
+					ID:          "AFG",
+					Name:        "Afghanistan",
+					CapitalCity: "Kabul",
+					Iso2Code:    "AF",
+					Longitude:   "69.1761",
+					Latitude:    "34.5228",
+					Region:      CountryRegion{ID: "SAS", Iso2Code: "8S", Value: "South Asia"},
+					IncomeLevel: IncomeLevel{ID: "LIC", Iso2Code: "XM", Value: "Low income"},
+					LendingType: LendingType{ID: "IDX", Iso2Code: "XI", Value: "IDA"},
+					AdminRegion: CountryRegion{ID: "SAS", Iso2Code: "8S", Value: "South Asia"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "success with aggregates",
+			args: args{
+				params: &ListCountryParams{
+					Classification: CountryClassificationAggregates,
+				},
+				pages: &PageParams{
+					Page:    testutils.TestDefaultPage,
+					PerPage: 4,
+				},
+			},
+			want: &PageSummary{
+				Page:    intOrString(testutils.TestDefaultPage),
+				PerPage: 4,
+			},
+			want1: []*Country{
+				{
+					ID:          "AFE",
+					Name:        "Africa Eastern and Southern",
+					Iso2Code:    "ZH",
+					Region:      CountryRegion{ID: "NA", Iso2Code: "NA", Value: "Aggregates"},
+					IncomeLevel: IncomeLevel{ID: "NA", Iso2Code: "NA", Value: "Aggregates"},
+					LendingType: LendingType{ID: "NA", Iso2Code: "NA", Value: "Aggregates"},
+				},
+				{
+					ID:          "AFR",
+					Name:        "Africa",
+					Iso2Code:    "A9",
+					Region:      CountryRegion{ID: "NA", Iso2Code: "NA", Value: "Aggregates"},
+					IncomeLevel: IncomeLevel{ID: "NA", Iso2Code: "NA", Value: "Aggregates"},
+					LendingType: LendingType{ID: "NA", Iso2Code: "NA", Value: "Aggregates"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "failure because invalid classification",
+			args: args{
+				params: &ListCountryParams{
+					Classification: "invalid_classification",
+				},
+			},
+			want:    nil,
+			want1:   nil,
+			wantErr: true,
+		},
 		{
 			name: "failure because invalid region id",
 			args: args{
@@ -463,6 +551,9 @@ func TestCountriesService_List(t *testing.T) {
 				t.Errorf("CountriesService.List() got = %v, want %v", got, tt.want)
 			}
 
+			if len(got1) != len(tt.want1) {
+				t.Fatalf("CountriesService.List() len(got1) = %d, want %d", len(got1), len(tt.want1))
+			}
 			for i := range got1 {
 				if !reflect.DeepEqual(got1[i], tt.want1[i]) {
 					t.Errorf("CountriesService.List() got1 = %v, want %v", got1[i], tt.want1[i])
@@ -628,3 +719,29 @@ func TestListCountryParams_toQueryParams(t *testing.T) {
 		})
 	}
 }
+
+func TestCountry_IsAggregate(t *testing.T) {
+	tests := []struct {
+		name    string
+		country *Country
+		want    bool
+	}{
+		{
+			name:    "economy",
+			country: &Country{ID: "JPN", Region: CountryRegion{ID: "EAS"}},
+			want:    false,
+		},
+		{
+			name:    "aggregate",
+			country: &Country{ID: "ARB", Region: CountryRegion{ID: "NA"}},
+			want:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.country.IsAggregate(); got != tt.want {
+				t.Errorf("Country.IsAggregate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
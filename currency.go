@@ -0,0 +1,321 @@
+package wbdata
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// ExchangeRateOfficial is the indicator ID of the official exchange rate, LCU per US$, period average
+	ExchangeRateOfficial = "PA.NUS.FCRF"
+	// ExchangeRatePPP is the indicator ID of the PPP conversion factor, LCU per international $
+	ExchangeRatePPP = "PA.NUS.PPP"
+
+	// ConversionToUSD converts values in local currency units to US$, or to international $ by ExchangeRatePPP
+	ConversionToUSD ConversionDirection = "to_usd"
+	// ConversionToLocal converts values in US$, or in international $ by ExchangeRatePPP, to local currency units
+	ConversionToLocal ConversionDirection = "to_local"
+
+	// UnitUSD is the unit of values converted to US$ by ExchangeRateOfficial
+	UnitUSD = "US$"
+	// UnitInternationalDollar is the unit of values converted by ExchangeRatePPP
+	UnitInternationalDollar = "international $"
+	// UnitLocalCurrency is the unit of values converted to local currency units
+	UnitLocalCurrency = "LCU"
+
+	// ObsStatusMissingRate is the obs status of values which are null because the rate of the period is missing
+	ObsStatusMissingRate = "missing rate"
+
+	// euroAreaID is the ID of the aggregate of the euro area, whose rates are used for the members without them
+	euroAreaID = "EMU"
+
+	usdSuffix   = "USD"
+	pppSuffix   = "PPP"
+	localSuffix = UnitLocalCurrency
+
+	defaultConversionPerPage = 1000
+)
+
+type (
+	// ConversionDirection is a direction of currency conversion
+	ConversionDirection string
+
+	// CurrencyConversionParams contains parameters for ConvertCurrency and IndicatorValuesService.ConvertCurrency
+	CurrencyConversionParams struct {
+		// CountryIDs are the countries to fetch. If it is empty, all countries are fetched.
+		CountryIDs   []string
+		FilterParams *FilterParams
+		// PerPage is the number of values requested at a time. Defaults to 1000.
+		PerPage int
+		// RateIndicatorID is the indicator of the rates in LCU per US$. Defaults to ExchangeRateOfficial.
+		// Rates in LCU per another currency can be used with Unit.
+		RateIndicatorID string
+		// Unit is the unit of converted values, e.g. EUR. Defaults to UnitLocalCurrency for ConversionToLocal,
+		// and to UnitUSD or UnitInternationalDollar for ExchangeRateOfficial or ExchangeRatePPP.
+		// It should be specified for other rate indicators.
+		Unit string
+		// Direction defaults to ConversionToUSD
+		Direction ConversionDirection
+		// Strict returns an error if the rate of a period is missing.
+		// Otherwise the value is null with ObsStatusMissingRate.
+		Strict bool
+		// LegacyEuroRates converts the rates of euro area members before the adoption of the euro
+		// from the former national currency to euro at the irrevocable rate, e.g. 1.95583 Deutsche Mark per euro.
+		// It is needed when the rates are in the former currency while the values are in euro, as in WDI.
+		LegacyEuroRates bool
+	}
+
+	// euroMember is a member of the euro area
+	euroMember struct {
+		iso2Code string
+		// adoption is the year when the euro became the currency of the member
+		adoption int
+		// rate is the irrevocable rate of the former national currency per euro
+		rate float64
+	}
+
+	// exchangeRates is the non-null rates keyed by country code and date
+	exchangeRates map[string]map[string]float64
+)
+
+// euroMembers are the members of the euro area keyed by ISO3 code
+var euroMembers = map[string]euroMember{
+	"AUT": {iso2Code: "AT", adoption: 1999, rate: 13.7603},
+	"BEL": {iso2Code: "BE", adoption: 1999, rate: 40.3399},
+	"DEU": {iso2Code: "DE", adoption: 1999, rate: 1.95583},
+	"ESP": {iso2Code: "ES", adoption: 1999, rate: 166.386},
+	"FIN": {iso2Code: "FI", adoption: 1999, rate: 5.94573},
+	"FRA": {iso2Code: "FR", adoption: 1999, rate: 6.55957},
+	"IRL": {iso2Code: "IE", adoption: 1999, rate: 0.787564},
+	"ITA": {iso2Code: "IT", adoption: 1999, rate: 1936.27},
+	"LUX": {iso2Code: "LU", adoption: 1999, rate: 40.3399},
+	"NLD": {iso2Code: "NL", adoption: 1999, rate: 2.20371},
+	"PRT": {iso2Code: "PT", adoption: 1999, rate: 200.482},
+	"GRC": {iso2Code: "GR", adoption: 2001, rate: 340.75},
+	"SVN": {iso2Code: "SI", adoption: 2007, rate: 239.64},
+	"CYP": {iso2Code: "CY", adoption: 2008, rate: 0.585274},
+	"MLT": {iso2Code: "MT", adoption: 2008, rate: 0.4293},
+	"SVK": {iso2Code: "SK", adoption: 2009, rate: 30.126},
+	"EST": {iso2Code: "EE", adoption: 2011, rate: 15.6466},
+	"LVA": {iso2Code: "LV", adoption: 2014, rate: 0.702804},
+	"LTU": {iso2Code: "LT", adoption: 2015, rate: 3.4528},
+	"HRV": {iso2Code: "HR", adoption: 2023, rate: 7.5345},
+}
+
+func (d ConversionDirection) String() string {
+	return string(d)
+}
+
+// IsMissingRate reports whether the value is null because the rate of the period is missing in the conversion
+func (iv *IndicatorValue) IsMissingRate() bool {
+	return iv.ObsStatus == ObsStatusMissingRate
+}
+
+// ConvertCurrency fetches the values of the indicator and the rates of the countries and converts the values.
+// The rates of the euro area are fetched too if any of the countries is a member.
+func (i *IndicatorValuesService) ConvertCurrency(indicatorID string, params *CurrencyConversionParams) ([]*IndicatorValue, error) {
+	if params == nil {
+		params = &CurrencyConversionParams{}
+	}
+	perPage := params.PerPage
+	if perPage == 0 {
+		perPage = defaultConversionPerPage
+	}
+
+	indicatorValues, err := i.listAll(params.CountryIDs, indicatorID, params.FilterParams, perPage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %v", indicatorID, err)
+	}
+	rateCountryIDs := params.CountryIDs
+	if hasEuroMember(rateCountryIDs) && !containsString(rateCountryIDs, euroAreaID) {
+		rateCountryIDs = append(append([]string{}, rateCountryIDs...), euroAreaID)
+	}
+	rateID := params.rateIndicatorID()
+	if _, err := params.conversionUnit(rateID, params.Direction); err != nil {
+		return nil, err
+	}
+	rates, err := i.listAll(rateCountryIDs, rateID, params.FilterParams, perPage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %v", rateID, err)
+	}
+
+	return ConvertCurrency(indicatorValues, rates, params)
+}
+
+// ConvertCurrency converts the values by the rates of the same country and period, in the order of the values.
+// Rates of a year are used for quarterly and monthly values without their own rates.
+// Euro area members without the rate of a period since the adoption of the euro use the rate of the euro area.
+// Only RateIndicatorID, Unit, Direction, Strict and LegacyEuroRates of params are used.
+func ConvertCurrency(indicatorValues, rates []*IndicatorValue, params *CurrencyConversionParams) ([]*IndicatorValue, error) {
+	if params == nil {
+		params = &CurrencyConversionParams{}
+	}
+	direction := params.Direction
+	if direction == "" {
+		direction = ConversionToUSD
+	}
+	if direction != ConversionToUSD && direction != ConversionToLocal {
+		return nil, fmt.Errorf("unsupported conversion direction: %s", direction)
+	}
+
+	rateID := params.rateIndicatorID()
+	unit, err := params.conversionUnit(rateID, direction)
+	if err != nil {
+		return nil, err
+	}
+	byCountry, rateName, err := newExchangeRates(rates, rateID)
+	if err != nil {
+		return nil, err
+	}
+
+	converted := make([]*IndicatorValue, 0, len(indicatorValues))
+	for _, iv := range indicatorValues {
+		p, err := parsePeriod(iv.Date)
+		if err != nil {
+			return nil, err
+		}
+		indicator := convertedIndicator(iv.Indicator, rateID, rateName, unit, direction)
+		cv := derivedValue(indicator, iv, unit)
+		if iv.IsNull() {
+			converted = append(converted, cv)
+			continue
+		}
+
+		rate, ok := byCountry.rate(iv.countryCode(), p, params.LegacyEuroRates)
+		if !ok {
+			if params.Strict {
+				return nil, fmt.Errorf("rate %s of %s in %s is missing", rateID, iv.countryCode(), iv.Date)
+			}
+			cv.ObsStatus = ObsStatusMissingRate
+			converted = append(converted, cv)
+			continue
+		}
+
+		cv.Value = iv.Value / rate
+		if direction == ConversionToLocal {
+			cv.Value = iv.Value * rate
+		}
+		cv.Null = false
+		converted = append(converted, cv)
+	}
+
+	return converted, nil
+}
+
+func (params *CurrencyConversionParams) rateIndicatorID() string {
+	if params.RateIndicatorID == "" {
+		return ExchangeRateOfficial
+	}
+
+	return params.RateIndicatorID
+}
+
+// newExchangeRates returns the non-null and non-zero rates of the indicator, and the name of the indicator
+func newExchangeRates(rates []*IndicatorValue, rateID string) (exchangeRates, string, error) {
+	byCountry := exchangeRates{}
+	name := rateID
+	for _, r := range rates {
+		if r.Indicator.ID != rateID {
+			continue
+		}
+		if r.Indicator.Value != "" {
+			name = r.Indicator.Value
+		}
+		if r.IsNull() || r.Value == 0 {
+			continue
+		}
+		p, err := parsePeriod(r.Date)
+		if err != nil {
+			return nil, "", err
+		}
+		code := r.countryCode()
+		if byCountry[code] == nil {
+			byCountry[code] = map[string]float64{}
+		}
+		byCountry[code][p.String()] = r.Value
+	}
+
+	return byCountry, name, nil
+}
+
+// rate returns the rate of the country in the period, falling back to the rate of the year
+// and then to the rate of the euro area for members since the adoption
+func (rates exchangeRates) rate(countryCode string, p period, legacyEuroRates bool) (float64, bool) {
+	year := period{frequency: FrequencyYearly, year: p.year, sub: 1}
+	lookup := func(code string) (float64, bool) {
+		if r, ok := rates[code][p.String()]; ok {
+			return r, true
+		}
+		r, ok := rates[code][year.String()]
+		return r, ok
+	}
+
+	member, isMember := euroMembers[countryCode]
+	r, ok := lookup(countryCode)
+	switch {
+	case ok && isMember && legacyEuroRates && p.year < member.adoption:
+		return r / member.rate, true
+	case ok:
+		return r, true
+	case isMember && p.year >= member.adoption:
+		return lookup(euroAreaID)
+	default:
+		return 0, false
+	}
+}
+
+// convertedIndicator returns the indicator converted by the rate, e.g. NY.GDP.MKTP.CN.USD.
+// The suffix is the ID of the rate for other rate indicators.
+func convertedIndicator(indicator IDAndValue, rateID, rateName, unit string, direction ConversionDirection) IDAndValue {
+	suffix := rateID
+	switch {
+	case direction == ConversionToLocal:
+		suffix = localSuffix
+	case rateID == ExchangeRatePPP:
+		suffix = pppSuffix
+	case rateID == ExchangeRateOfficial:
+		suffix = usdSuffix
+	}
+	name := indicator.Value
+	if name == "" {
+		name = indicator.ID
+	}
+
+	return IDAndValue{
+		ID:    indicator.ID + "." + suffix,
+		Value: fmt.Sprintf("%s, converted to %s by %s", name, unit, rateName),
+	}
+}
+
+// conversionUnit returns the unit of converted values, and an error if it is unknown for the rate
+func (params *CurrencyConversionParams) conversionUnit(rateID string, direction ConversionDirection) (string, error) {
+	switch {
+	case params.Unit != "":
+		return params.Unit, nil
+	case direction == ConversionToLocal:
+		return UnitLocalCurrency, nil
+	case rateID == ExchangeRatePPP:
+		return UnitInternationalDollar, nil
+	case rateID == ExchangeRateOfficial:
+		return UnitUSD, nil
+	default:
+		return "", fmt.Errorf("unit should be specified for rate %s", rateID)
+	}
+}
+
+// hasEuroMember reports whether any of the ISO3 codes or the ISO2 codes is of a member of the euro area
+func hasEuroMember(countryIDs []string) bool {
+	for _, id := range countryIDs {
+		id = strings.ToUpper(id)
+		if _, ok := euroMembers[id]; ok {
+			return true
+		}
+		for _, m := range euroMembers {
+			if m.iso2Code == id {
+				return true
+			}
+		}
+	}
+
+	return false
+}
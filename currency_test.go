@@ -0,0 +1,169 @@
+package wbdata
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/jkkitakita/wbdata-go/testutils"
+)
+
+func TestConvertCurrency(t *testing.T) {
+	nan := math.NaN()
+	values := []*IndicatorValue{
+		newTestValue("NY.GDP.MKTP.CN", "JPN", "2019", 550),
+		newTestValue("NY.GDP.MKTP.CN", "JPN", "2019Q2", 110),
+		newTestNullValue("NY.GDP.MKTP.CN", "JPN", "2018"),
+		newTestValue("NY.GDP.MKTP.CN", "DEU", "1998", 1.95583),
+		newTestValue("NY.GDP.MKTP.CN", "DEU", "2019", 9),
+		newTestValue("NY.GDP.MKTP.CN", "USA", "2019", 20),
+	}
+	rates := []*IndicatorValue{
+		newTestValue(ExchangeRateOfficial, "JPN", "2019", 110),
+		newTestValue(ExchangeRateOfficial, "DEU", "1998", 1.95583),
+		newTestNullValue(ExchangeRateOfficial, "DEU", "2019"),
+		newTestValue(ExchangeRateOfficial, euroAreaID, "2019", 0.9),
+		newTestValue(ExchangeRatePPP, "USA", "2019", 1),
+		newTestValue("PA.NUS.EUR", "USA", "2019", 0.9),
+	}
+	rates[0].Indicator.Value = "Official exchange rate"
+
+	tests := []struct {
+		name       string
+		params     *CurrencyConversionParams
+		wantID     string
+		wantUnit   string
+		want       []float64
+		wantStatus []string
+		wantErr    bool
+	}{
+		{
+			name:       "to US$ with the rate of the euro area",
+			params:     nil,
+			wantID:     "NY.GDP.MKTP.CN.USD",
+			wantUnit:   UnitUSD,
+			want:       []float64{5, 1, nan, 1, 10, nan},
+			wantStatus: []string{"", "", "", "", "", ObsStatusMissingRate},
+		},
+		{
+			name:       "to local currency units with legacy euro rates",
+			params:     &CurrencyConversionParams{Direction: ConversionToLocal, LegacyEuroRates: true},
+			wantID:     "NY.GDP.MKTP.CN.LCU",
+			wantUnit:   UnitLocalCurrency,
+			want:       []float64{60500, 12100, nan, 1.95583, 8.1, nan},
+			wantStatus: []string{"", "", "", "", "", ObsStatusMissingRate},
+		},
+		{
+			name:       "to international $",
+			params:     &CurrencyConversionParams{RateIndicatorID: ExchangeRatePPP},
+			wantID:     "NY.GDP.MKTP.CN.PPP",
+			wantUnit:   UnitInternationalDollar,
+			want:       []float64{nan, nan, nan, nan, nan, 20},
+			wantStatus: []string{ObsStatusMissingRate, ObsStatusMissingRate, "", ObsStatusMissingRate, ObsStatusMissingRate, ""},
+		},
+		{
+			name:       "to the unit of another rate",
+			params:     &CurrencyConversionParams{RateIndicatorID: "PA.NUS.EUR", Unit: "EUR"},
+			wantID:     "NY.GDP.MKTP.CN.PA.NUS.EUR",
+			wantUnit:   "EUR",
+			want:       []float64{nan, nan, nan, nan, nan, 20 / 0.9},
+			wantStatus: []string{ObsStatusMissingRate, ObsStatusMissingRate, "", ObsStatusMissingRate, ObsStatusMissingRate, ""},
+		},
+		{
+			name:    "failure because of a missing unit of another rate",
+			params:  &CurrencyConversionParams{RateIndicatorID: "PA.NUS.EUR"},
+			wantErr: true,
+		},
+		{
+			name:    "failure because of a missing rate",
+			params:  &CurrencyConversionParams{Strict: true},
+			wantErr: true,
+		},
+		{
+			name:    "failure because of an unsupported direction",
+			params:  &CurrencyConversionParams{Direction: "invalid_direction"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ConvertCurrency(values, rates, tt.params)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ConvertCurrency() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			gotValues := []float64{}
+			gotStatus := []string{}
+			for _, iv := range got {
+				v := iv.Value
+				if iv.IsNull() {
+					v = nan
+				}
+				gotValues = append(gotValues, v)
+				gotStatus = append(gotStatus, iv.ObsStatus)
+				if iv.Indicator.ID != tt.wantID || iv.Unit != tt.wantUnit {
+					t.Errorf("ConvertCurrency() = %+v, want ID %v and unit %v", iv, tt.wantID, tt.wantUnit)
+				}
+			}
+			if !equalValues(gotValues, tt.want) {
+				t.Errorf("ConvertCurrency() values = %v, want %v", gotValues, tt.want)
+			}
+			if !reflect.DeepEqual(gotStatus, tt.wantStatus) {
+				t.Errorf("ConvertCurrency() obs status = %v, want %v", gotStatus, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestIndicatorValuesService_ConvertCurrency(t *testing.T) {
+	client, save := NewTestClient(t, *update)
+	defer save()
+
+	i := &IndicatorValuesService{
+		client: client,
+	}
+	params := &CurrencyConversionParams{
+		CountryIDs: []string{"DEU", "JPN"},
+		FilterParams: &FilterParams{
+			FilterParamsType: FilterParamsDateRange,
+			DateParam: &DateParam{
+				DateRange: &DateRange{
+					Start: testutils.TestDefaultDateStart,
+					End:   testutils.TestDefaultDateEnd,
+				},
+			},
+		},
+		PerPage: testutils.TestDefaultPerPage,
+	}
+
+	got, err := i.ConvertCurrency("NY.GDP.MKTP.CN", params)
+	if err != nil {
+		t.Fatalf("IndicatorValuesService.ConvertCurrency() error = %v", err)
+	}
+	if len(got) != 4 {
+		t.Fatalf("IndicatorValuesService.ConvertCurrency() len = %d, want 4", len(got))
+	}
+
+	// the rate of Germany is null in 2019, so the rate of the euro area is used
+	rate2019 := 0.893
+	if germany := got[0]; germany.Countryiso3code != "DEU" || germany.Date != "2019" || germany.Value != 3473260000000/rate2019 {
+		t.Errorf("IndicatorValuesService.ConvertCurrency()[0] = %+v", germany)
+	}
+	wantIndicator := IDAndValue{
+		ID:    "NY.GDP.MKTP.CN.USD",
+		Value: "GDP (current LCU), converted to US$ by Official exchange rate (LCU per US$, period average)",
+	}
+	if got[0].Indicator != wantIndicator || got[0].Unit != UnitUSD {
+		t.Errorf("IndicatorValuesService.ConvertCurrency()[0] = %+v", got[0])
+	}
+	if japan := got[3]; japan.Countryiso3code != "JPN" || japan.Date != "2018" || !japan.IsNull() || japan.IsMissingRate() {
+		t.Errorf("IndicatorValuesService.ConvertCurrency()[3] = %+v", japan)
+	}
+
+	if _, err := i.ConvertCurrency(testutils.TestInvalidIndicatorID, params); err == nil {
+		t.Errorf("IndicatorValuesService.ConvertCurrency() error = nil, want error")
+	}
+}
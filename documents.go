@@ -0,0 +1,200 @@
+package wbdata
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	documentsPath = "wds"
+	// documentsFacetsKey is the key of facets in the documents, which is not a document
+	documentsFacetsKey = "facets"
+)
+
+type (
+	// DocumentsService ...
+	DocumentsService service
+
+	// DocumentParams contains parameters for DocumentsService.List
+	DocumentParams struct {
+		// Keyword is a full-text query, e.g. wind turbine
+		Keyword string
+		// CountryNames are the names of countries in the Documents & Reports API, e.g. India
+		CountryNames []string
+		// DocumentType is a document type, e.g. Project Information Document
+		DocumentType string
+		// DateFrom and DateTo are the range of the document date. A zero value is unbounded.
+		DateFrom time.Time
+		DateTo   time.Time
+	}
+
+	// Document represents a document of the Documents & Reports API
+	Document struct {
+		ID                string         `json:"id"`
+		Title             string         `json:"display_title"`
+		DocumentType      string         `json:"docty"`
+		MajorDocumentType string         `json:"majdocty"`
+		DocumentDate      string         `json:"docdt"`
+		CountryNames      searchStrings  `json:"count"`
+		Language          string         `json:"lang"`
+		ReportNumber      string         `json:"repnb"`
+		Abstract          searchAbstract `json:"abstracts"`
+		PDFURL            string         `json:"pdfurl"`
+		URL               string         `json:"url"`
+	}
+
+	// CountryNameResolver resolves country names in the search APIs to Country IDs
+	CountryNameResolver struct {
+		ids map[string]string
+	}
+
+	documentsResponse struct {
+		Rows      intOrString  `json:"rows"`
+		Offset    intOrString  `json:"os"`
+		Page      intOrString  `json:"page"`
+		Total     intOrString  `json:"total"`
+		Documents documentsMap `json:"documents"`
+	}
+
+	// documentsMap is documents keyed by ID in the order of the response
+	documentsMap []*Document
+
+	// searchAbstract is an abstract, which is an object with cdata! or a string
+	searchAbstract string
+)
+
+// List returns a Response's Summary and Documents with params
+func (d *DocumentsService) List(params *DocumentParams, pages *PageParams) (*PageSummary, []*Document, error) {
+	queryParams, err := params.toQueryParams()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows, offset, err := pages.searchRowsAndOffset()
+	if err != nil {
+		return nil, nil, err
+	}
+	queryParams["rows"] = strconv.Itoa(rows)
+	queryParams["os"] = strconv.Itoa(offset)
+
+	req, err := d.client.newSearchRequest(d.client.DocumentsBaseURL, documentsPath, queryParams)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res := &documentsResponse{}
+	if err := d.client.do(req, res); err != nil {
+		return nil, nil, err
+	}
+
+	return newSearchPageSummary(rows, offset, int(res.Total)), res.Documents, nil
+}
+
+// Date returns the document date. It returns a zero value if the date is empty.
+func (doc *Document) Date() (time.Time, error) {
+	return parseSearchDate(doc.DocumentDate)
+}
+
+// CountryIDs returns the Country IDs of the document. Names which are not resolved, e.g. World, are skipped.
+func (doc *Document) CountryIDs(resolver *CountryNameResolver) []string {
+	ids := []string{}
+	for _, name := range doc.CountryNames {
+		if id, ok := resolver.Resolve(name); ok {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// NewCountryNameResolver returns a CountryNameResolver of countries, e.g. the result of CountriesService.List.
+// Names are matched ignoring case and punctuation, e.g. "Korea, Rep." matches "Korea Rep".
+func NewCountryNameResolver(countries []*Country) *CountryNameResolver {
+	r := &CountryNameResolver{ids: map[string]string{}}
+	for _, c := range countries {
+		r.AddAlias(c.Name, c.ID)
+	}
+
+	return r
+}
+
+// AddAlias adds a name of the country of id, e.g. "Korea, Republic of" for KOR
+func (r *CountryNameResolver) AddAlias(name, id string) {
+	if key := countryNameKey(name); key != "" {
+		r.ids[key] = id
+	}
+}
+
+// Resolve returns the Country ID of the name
+func (r *CountryNameResolver) Resolve(name string) (string, bool) {
+	id, ok := r.ids[countryNameKey(name)]
+
+	return id, ok
+}
+
+func (params *DocumentParams) toQueryParams() (map[string]string, error) {
+	queryParams := map[string]string{}
+	if params == nil {
+		return queryParams, nil
+	}
+
+	queryParams["qterm"] = params.Keyword
+	queryParams["count_exact"] = strings.Join(params.CountryNames, searchValuesSeparator)
+	queryParams["docty_exact"] = params.DocumentType
+
+	from, to := params.DateFrom, params.DateTo
+	if !from.IsZero() && !to.IsZero() && from.After(to) {
+		return nil, fmt.Errorf("date from should be before to, from: %v to: %v", from, to)
+	}
+	if !from.IsZero() {
+		queryParams["strdate"] = from.Format(searchDateLayout)
+	}
+	if !to.IsZero() {
+		queryParams["enddate"] = to.Format(searchDateLayout)
+	}
+
+	return queryParams, nil
+}
+
+// countryNameKey normalizes a country name to lowercase letters and digits separated by spaces
+func countryNameKey(name string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
+
+func (m *documentsMap) UnmarshalJSON(data []byte) error {
+	return forEachSearchObject(data, func(key string, raw json.RawMessage) error {
+		if key == documentsFacetsKey {
+			return nil
+		}
+		doc := &Document{}
+		if err := json.Unmarshal(raw, doc); err != nil {
+			return err
+		}
+		*m = append(*m, doc)
+		return nil
+	})
+}
+
+func (sa *searchAbstract) UnmarshalJSON(data []byte) error {
+	var abstract struct {
+		CDATA string `json:"cdata!"`
+	}
+	if err := json.Unmarshal(data, &abstract); err == nil {
+		*sa = searchAbstract(abstract.CDATA)
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	*sa = searchAbstract(s)
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package wbdata
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jkkitakita/wbdata-go/testutils"
+)
+
+func TestDocumentsService_List(t *testing.T) {
+	type args struct {
+		params *DocumentParams
+		pages  *PageParams
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantQuery url.Values
+		want      *PageSummary
+		want1     []string
+		wantErr   bool
+	}{
+		{
+			name: "success",
+			args: args{
+				params: &DocumentParams{
+					Keyword:      "wind turbine",
+					CountryNames: []string{"India", "Korea, Republic of"},
+					DocumentType: "Working Paper",
+					DateFrom:     time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					DateTo:       time.Date(2021, 12, 31, 0, 0, 0, 0, time.UTC),
+				},
+				pages: &PageParams{
+					Page:    testutils.TestDefaultPage,
+					PerPage: testutils.TestDefaultPerPage,
+				},
+			},
+			wantQuery: url.Values{
+				"format":      {"json"},
+				"qterm":       {"wind turbine"},
+				"count_exact": {"India^Korea, Republic of"},
+				"docty_exact": {"Working Paper"},
+				"strdate":     {"2021-01-01"},
+				"enddate":     {"2021-12-31"},
+				"rows":        {"2"},
+				"os":          {"0"},
+			},
+			want: &PageSummary{
+				Page:    1,
+				Pages:   3,
+				PerPage: 2,
+				Total:   5,
+			},
+			want1:   []string{"34012345", "33998877"},
+			wantErr: false,
+		},
+		{
+			name: "success without params",
+			args: args{},
+			wantQuery: url.Values{
+				"format": {"json"},
+				"rows":   {"10"},
+				"os":     {"0"},
+			},
+			want: &PageSummary{
+				Page:    1,
+				Pages:   1,
+				PerPage: 10,
+				Total:   5,
+			},
+			want1:   []string{"34012345", "33998877"},
+			wantErr: false,
+		},
+		{
+			name: "failure because date from is after to",
+			args: args{
+				params: &DocumentParams{
+					DateFrom: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					DateTo:   time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "failure because Page is less than 1",
+			args: args{
+				pages: &PageParams{
+					Page:    testutils.TestInvalidPage,
+					PerPage: testutils.TestDefaultPerPage,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, closeServer := newTestSearchClient(t, "documents.json", tt.wantQuery)
+			defer closeServer()
+
+			d := &DocumentsService{
+				client: client,
+			}
+			got, got1, err := d.List(tt.args.params, tt.args.pages)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DocumentsService.List() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DocumentsService.List() got = %+v, want %+v", got, tt.want)
+			}
+			ids := []string{}
+			for _, doc := range got1 {
+				ids = append(ids, doc.ID)
+			}
+			if !reflect.DeepEqual(ids, tt.want1) {
+				t.Errorf("DocumentsService.List() got1 = %v, want %v", ids, tt.want1)
+			}
+		})
+	}
+}
+
+func TestDocument_CountryIDs(t *testing.T) {
+	client, closeServer := newTestSearchClient(t, "documents.json", url.Values{"format": {"json"}, "rows": {"10"}, "os": {"0"}})
+	defer closeServer()
+
+	_, docs, err := client.Documents.List(nil, nil)
+	if err != nil {
+		t.Fatalf("DocumentsService.List() error = %v", err)
+	}
+
+	plan := docs[0]
+	if plan.Abstract != "Procurement plan for wind turbine installations." || plan.MajorDocumentType != "Project Documents" {
+		t.Errorf("Document = %+v", plan)
+	}
+	if got, _ := plan.Date(); !got.Equal(time.Date(2021, 3, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Document.Date() = %v", got)
+	}
+
+	resolver := NewCountryNameResolver([]*Country{
+		{ID: "IND", Name: "India"},
+		{ID: "KOR", Name: "Korea, Rep."},
+	})
+	if got := plan.CountryIDs(resolver); !reflect.DeepEqual(got, []string{"IND"}) {
+		t.Errorf("Document.CountryIDs() = %v, want %v", got, []string{"IND"})
+	}
+
+	paper := docs[1]
+	if paper.Abstract != "This paper reviews wind turbine deployment." || paper.ReportNumber != "WPS9520" {
+		t.Errorf("Document = %+v", paper)
+	}
+	if got := paper.CountryIDs(resolver); !reflect.DeepEqual(got, []string{"IND"}) {
+		t.Errorf("Document.CountryIDs() = %v, want %v", got, []string{"IND"})
+	}
+	resolver.AddAlias("Korea, Republic of", "KOR")
+	if got := paper.CountryIDs(resolver); !reflect.DeepEqual(got, []string{"IND", "KOR"}) {
+		t.Errorf("Document.CountryIDs() = %v, want %v", got, []string{"IND", "KOR"})
+	}
+	if got, ok := resolver.Resolve("korea rep"); !ok || got != "KOR" {
+		t.Errorf("CountryNameResolver.Resolve() = %v, %v, want KOR, true", got, ok)
+	}
+}
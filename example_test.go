@@ -157,7 +157,7 @@ func ExampleIndicatorValuesService_List() {
 	fmt.Printf("IndicatorValues[0]: %#v\n", indicatorValues[0])
 	// Output:
 	// Summary is: &wbdata.PageSummaryWithSourceID{Page:1, Pages:53, PerPage:10, Total:528, SourceID:"2", LastUpdated:"2020-12-16"}
-	// IndicatorValues[0]: &wbdata.IndicatorValue{Indicator:wbdata.IDAndValue{ID:"NY.GDP.MKTP.CD", Value:"GDP (current US$)"}, Country:wbdata.IDAndValue{ID:"1A", Value:"Arab World"}, Countryiso3code:"ARB", Date:"2019", Value:2.81741458466511e+12, Unit:"", ObsStatus:"", Decimal:0}
+	// IndicatorValues[0]: &wbdata.IndicatorValue{Indicator:wbdata.IDAndValue{ID:"NY.GDP.MKTP.CD", Value:"GDP (current US$)"}, Country:wbdata.IDAndValue{ID:"1A", Value:"Arab World"}, Countryiso3code:"ARB", Date:"2019", Value:2.81741458466511e+12, Unit:"", ObsStatus:"", Decimal:0, Null:false}
 }
 
 func ExampleIndicatorValuesService_List_second() {
@@ -188,7 +188,7 @@ func ExampleIndicatorValuesService_List_second() {
 	fmt.Printf("IndicatorValues[0]: %#v\n", indicatorValues[0])
 	// Output:
 	// Summary is: &wbdata.PageSummaryWithSourceID{Page:1, Pages:53, PerPage:10, Total:528, SourceID:"2", LastUpdated:"2020-12-16"}
-	// IndicatorValues[0]: &wbdata.IndicatorValue{Indicator:wbdata.IDAndValue{ID:"NY.GDP.MKTP.CD", Value:"GDP (current US$)"}, Country:wbdata.IDAndValue{ID:"1A", Value:"Arab World"}, Countryiso3code:"ARB", Date:"2019", Value:2.81741458466511e+12, Unit:"", ObsStatus:"", Decimal:0}
+	// IndicatorValues[0]: &wbdata.IndicatorValue{Indicator:wbdata.IDAndValue{ID:"NY.GDP.MKTP.CD", Value:"GDP (current US$)"}, Country:wbdata.IDAndValue{ID:"1A", Value:"Arab World"}, Countryiso3code:"ARB", Date:"2019", Value:2.81741458466511e+12, Unit:"", ObsStatus:"", Decimal:0, Null:false}
 }
 
 func ExampleIndicatorValuesService_ListByCountryIDs() {
@@ -219,7 +219,7 @@ func ExampleIndicatorValuesService_ListByCountryIDs() {
 	fmt.Printf("IndicatorValues[0]: %#v\n", indicatorValues[0])
 	// Output:
 	// Summary is: &wbdata.PageSummaryWithSourceID{Page:1, Pages:1, PerPage:10, Total:4, SourceID:"2", LastUpdated:"2020-12-16"}
-	// IndicatorValues[0]: &wbdata.IndicatorValue{Indicator:wbdata.IDAndValue{ID:"NY.GDP.MKTP.CD", Value:"GDP (current US$)"}, Country:wbdata.IDAndValue{ID:"JP", Value:"Japan"}, Countryiso3code:"JPN", Date:"2019", Value:5.08176954237977e+12, Unit:"", ObsStatus:"", Decimal:0}
+	// IndicatorValues[0]: &wbdata.IndicatorValue{Indicator:wbdata.IDAndValue{ID:"NY.GDP.MKTP.CD", Value:"GDP (current US$)"}, Country:wbdata.IDAndValue{ID:"JP", Value:"Japan"}, Countryiso3code:"JPN", Date:"2019", Value:5.08176954237977e+12, Unit:"", ObsStatus:"", Decimal:0, Null:false}
 }
 
 func ExampleIndicatorValuesService_ListBySourceID() {
@@ -252,7 +252,7 @@ func ExampleIndicatorValuesService_ListBySourceID() {
 	fmt.Printf("IndicatorValues[0]: %#v\n", indicatorValues[0])
 	// Output:
 	// Summary is: &wbdata.PageSummaryWithLastUpdated{Page:1, Pages:106, PerPage:10, Total:1056, LastUpdated:"2020-12-16"}
-	// IndicatorValues[0]: &wbdata.IndicatorValue{Indicator:wbdata.IDAndValue{ID:"NY.GDP.MKTP.CD", Value:"GDP (current US$)"}, Country:wbdata.IDAndValue{ID:"1A", Value:"Arab World"}, Countryiso3code:"ARB", Date:"2019", Value:2.81741458466511e+12, Unit:"", ObsStatus:"", Decimal:0}
+	// IndicatorValues[0]: &wbdata.IndicatorValue{Indicator:wbdata.IDAndValue{ID:"NY.GDP.MKTP.CD", Value:"GDP (current US$)"}, Country:wbdata.IDAndValue{ID:"1A", Value:"Arab World"}, Countryiso3code:"ARB", Date:"2019", Value:2.81741458466511e+12, Unit:"", ObsStatus:"", Decimal:0, Null:false}
 }
 
 func ExampleIndicatorValuesService_ListByCountryIDsAndSourceID() {
@@ -287,7 +287,7 @@ func ExampleIndicatorValuesService_ListByCountryIDsAndSourceID() {
 	fmt.Printf("IndicatorValues[0]: %#v\n", indicatorValues[0])
 	// Output:
 	// Summary is: &wbdata.PageSummaryWithLastUpdated{Page:1, Pages:106, PerPage:10, Total:1056, LastUpdated:"2020-12-16"}
-	// IndicatorValues[0]: &wbdata.IndicatorValue{Indicator:wbdata.IDAndValue{ID:"NY.GDP.MKTP.CD", Value:"GDP (current US$)"}, Country:wbdata.IDAndValue{ID:"JP", Value:"Japan"}, Countryiso3code:"JPN", Date:"2019", Value:5.08176954237977e+12, Unit:"", ObsStatus:"", Decimal:0}
+	// IndicatorValues[0]: &wbdata.IndicatorValue{Indicator:wbdata.IDAndValue{ID:"NY.GDP.MKTP.CD", Value:"GDP (current US$)"}, Country:wbdata.IDAndValue{ID:"JP", Value:"Japan"}, Countryiso3code:"JPN", Date:"2019", Value:5.08176954237977e+12, Unit:"", ObsStatus:"", Decimal:0, Null:false}
 }
 
 func ExampleLendingTypesService_List() {
@@ -366,7 +366,7 @@ func ExampleSourcesService_List() {
 	fmt.Printf("Sources[0] is: %#v\n", sources[0])
 	// Output:
 	// Summary is: &wbdata.PageSummary{Page:1, Pages:6, PerPage:10, Total:59}
-	// Sources[0] is: &wbdata.Source{ID:"1", LastUpdated:"2019-10-23", Name:"Doing Business", Code:"DBS", Description:"", URL:"", DataAvailability:"Y", MetadataAvailability:"Y", Concepts:"3"}
+	// Sources[0] is: &wbdata.Source{ID:"1", LastUpdated:"2019-10-23", Name:"Doing Business", Code:"DBS", Description:"", URL:"", DataAvailability:"Y", MetadataAvailability:"Y", Concepts:3}
 }
 
 func ExampleSourcesService_Get() {
@@ -377,7 +377,7 @@ func ExampleSourcesService_Get() {
 	fmt.Printf("Source is: %#v\n", source)
 	// Output:
 	// Summary is: &wbdata.PageSummary{Page:1, Pages:1, PerPage:50, Total:1}
-	// Source is: &wbdata.Source{ID:"1", LastUpdated:"2019-10-23", Name:"Doing Business", Code:"DBS", Description:"", URL:"", DataAvailability:"Y", MetadataAvailability:"Y", Concepts:"3"}
+	// Source is: &wbdata.Source{ID:"1", LastUpdated:"2019-10-23", Name:"Doing Business", Code:"DBS", Description:"", URL:"", DataAvailability:"Y", MetadataAvailability:"Y", Concepts:3}
 }
 
 func ExampleTopicsService_List() {
@@ -0,0 +1,505 @@
+package wbdata
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const defaultDerivedPerPage = 1000
+
+var (
+	// exprMantissaRegex matches a number followed by an exponent mark, e.g. 1.5e of 1.5e-3
+	exprMantissaRegex = regexp.MustCompile(`^(\d+\.?\d*|\.\d+)[eE]$`)
+	// exprNumberRegex matches a term which is written as a number, e.g. 1.5e-3, and 1e which is invalid
+	exprNumberRegex = regexp.MustCompile(`^(\d+\.?\d*|\.\d+)([eE][+-]?\d*)?$`)
+	// exprIndicatorIDRegex matches an indicator ID, e.g. NY.GDP.MKTP.CD and 1.0.HCount.1.90usd
+	exprIndicatorIDRegex = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._]*$`)
+)
+
+const (
+	exprPrecedenceAdditive = iota
+	exprPrecedenceMultiplicative
+	exprPrecedenceUnary
+	exprPrecedencePrimary
+)
+
+type (
+	// Expression is an arithmetic expression over indicator IDs, e.g. NY.GDP.MKTP.CD / SP.POP.TOTL * 1000.
+	// It supports +, -, *, /, unary minus, parentheses and numbers, e.g. 1000 and 1e-3.
+	Expression struct {
+		// IndicatorIDs are the indicator IDs referenced in the expression in order of appearance
+		IndicatorIDs []string
+
+		root exprNode
+	}
+
+	// DerivedIndicatorParams contains parameters for IndicatorValuesService.Derive and Expression.Evaluate
+	DerivedIndicatorParams struct {
+		// CountryIDs are the countries to fetch. If it is empty, all countries are fetched.
+		CountryIDs   []string
+		FilterParams *FilterParams
+		// PerPage is the number of values requested at a time. Defaults to 1000.
+		PerPage int
+		// ID, Name and Unit of the derived indicator. They are generated from the expression if empty.
+		ID   string
+		Name string
+		Unit string
+	}
+
+	exprNode interface {
+		// eval returns the value, and false if any operand is null or missing, or it is divided by zero
+		eval(operands map[string]*IndicatorValue) (float64, bool)
+		// format returns the expression with the labels of the indicators
+		format(label func(indicatorID string) string) string
+		precedence() int
+	}
+
+	exprNumber float64
+
+	exprIndicator string
+
+	exprNegation struct {
+		operand exprNode
+	}
+
+	exprBinary struct {
+		op          string
+		left, right exprNode
+	}
+
+	exprParser struct {
+		tokens []string
+		pos    int
+		ids    []string
+	}
+
+	// derivedKey is a country and a period where the operands are aligned
+	derivedKey struct {
+		countryID string
+		date      string
+	}
+
+	// derivedOperands are the values of the indicators aligned by country and period
+	derivedOperands struct {
+		values  map[derivedKey]map[string]*IndicatorValue
+		periods map[derivedKey]period
+		names   map[string]string
+		units   map[string]string
+	}
+)
+
+// ParseExpression parses an arithmetic expression over indicator IDs.
+// A term which is not a number is an indicator ID, e.g. 1.0.HCount.1.90usd.
+func ParseExpression(s string) (*Expression, error) {
+	p := &exprParser{tokens: tokenizeExpression(s)}
+	if len(p.tokens) == 0 {
+		return nil, errors.New("expression should not be empty")
+	}
+
+	root, err := p.parseAdditive()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %q: %v", s, err)
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("failed to parse %q: unexpected %q", s, p.tokens[p.pos])
+	}
+
+	return &Expression{IndicatorIDs: p.ids, root: root}, nil
+}
+
+// String returns the expression in the canonical form, e.g. NY.GDP.MKTP.CD / SP.POP.TOTL * 1000
+func (e *Expression) String() string {
+	return e.root.format(func(indicatorID string) string {
+		return indicatorID
+	})
+}
+
+// Derive fetches the indicators referenced in the expression and returns the values of the derived indicator
+func (i *IndicatorValuesService) Derive(expression string, params *DerivedIndicatorParams) ([]*IndicatorValue, error) {
+	e, err := ParseExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+	if params == nil {
+		params = &DerivedIndicatorParams{}
+	}
+	perPage := params.PerPage
+	if perPage == 0 {
+		perPage = defaultDerivedPerPage
+	}
+
+	indicatorValues := []*IndicatorValue{}
+	for _, id := range e.IndicatorIDs {
+		ivs, err := i.listAll(params.CountryIDs, id, params.FilterParams, perPage)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch %s: %v", id, err)
+		}
+		indicatorValues = append(indicatorValues, ivs...)
+	}
+
+	return e.Evaluate(indicatorValues, params)
+}
+
+// Evaluate aligns indicator values by country and date, and returns the values of the derived indicator
+// ordered by country and date. A value is null if any operand is null or missing, or it is divided by zero.
+// Only ID, Name and Unit of params are used.
+func (e *Expression) Evaluate(indicatorValues []*IndicatorValue, params *DerivedIndicatorParams) ([]*IndicatorValue, error) {
+	if params == nil {
+		params = &DerivedIndicatorParams{}
+	}
+
+	operands, err := e.align(indicatorValues)
+	if err != nil {
+		return nil, err
+	}
+
+	indicator := IDAndValue{ID: params.ID, Value: params.Name}
+	if indicator.ID == "" {
+		indicator.ID = strings.ReplaceAll(e.String(), " ", "")
+	}
+	if indicator.Value == "" {
+		indicator.Value = e.root.format(func(id string) string {
+			if name := operands.names[id]; name != "" {
+				return name
+			}
+			return id
+		})
+	}
+	unit := params.Unit
+	if unit == "" {
+		unit = e.unit(operands.names, operands.units)
+	}
+
+	keys := operands.keys()
+	derived := make([]*IndicatorValue, 0, len(keys))
+	for _, key := range keys {
+		values := operands.values[key]
+		var template *IndicatorValue
+		for _, id := range e.IndicatorIDs {
+			if iv, ok := values[id]; ok {
+				template = iv
+				break
+			}
+		}
+		v, ok := e.root.eval(values)
+		derived = append(derived, &IndicatorValue{
+			Indicator:       indicator,
+			Country:         template.Country,
+			Countryiso3code: template.Countryiso3code,
+			Date:            key.date,
+			Value:           v,
+			Unit:            unit,
+			Null:            !ok,
+		})
+	}
+
+	return derived, nil
+}
+
+// align aligns the values of the referenced indicators by country and period
+func (e *Expression) align(indicatorValues []*IndicatorValue) (*derivedOperands, error) {
+	operands := &derivedOperands{
+		values:  map[derivedKey]map[string]*IndicatorValue{},
+		periods: map[derivedKey]period{},
+		names:   map[string]string{},
+		units:   map[string]string{},
+	}
+	for _, iv := range indicatorValues {
+		if !containsString(e.IndicatorIDs, iv.Indicator.ID) {
+			continue
+		}
+		p, err := parsePeriod(iv.Date)
+		if err != nil {
+			return nil, err
+		}
+		key := derivedKey{countryID: iv.countryCode(), date: p.String()}
+		if operands.values[key] == nil {
+			operands.values[key] = map[string]*IndicatorValue{}
+			operands.periods[key] = p
+		}
+		operands.values[key][iv.Indicator.ID] = iv
+		if operands.names[iv.Indicator.ID] == "" {
+			operands.names[iv.Indicator.ID] = iv.Indicator.Value
+		}
+		if operands.units[iv.Indicator.ID] == "" {
+			operands.units[iv.Indicator.ID] = iv.Unit
+		}
+	}
+
+	return operands, nil
+}
+
+// keys returns the keys ordered by country and period
+func (o *derivedOperands) keys() []derivedKey {
+	keys := make([]derivedKey, 0, len(o.values))
+	for key := range o.values {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].countryID != keys[j].countryID {
+			return keys[i].countryID < keys[j].countryID
+		}
+		return o.periods[keys[i]].before(o.periods[keys[j]])
+	})
+
+	return keys
+}
+
+// unit returns the unit of the expression with the units of the indicators, e.g. current US$ / people * 1000.
+// The unit of an indicator is Unit of the values, or the last parenthesized text of the name, e.g. current US$.
+// It returns an empty string if the unit of any indicator is unknown.
+func (e *Expression) unit(names, units map[string]string) string {
+	known := true
+	unit := e.root.format(func(id string) string {
+		if u := units[id]; u != "" {
+			return u
+		}
+		if u := nameUnit(names[id]); u != "" {
+			return u
+		}
+		known = false
+		return ""
+	})
+	if !known {
+		return ""
+	}
+
+	return unit
+}
+
+// nameUnit returns the parenthesized text at the end of an indicator name, e.g. current US$ of GDP (current US$)
+func nameUnit(name string) string {
+	name = strings.TrimSpace(name)
+	if !strings.HasSuffix(name, ")") {
+		return ""
+	}
+	i := strings.LastIndex(name, "(")
+	if i < 0 {
+		return ""
+	}
+
+	return name[i+1 : len(name)-1]
+}
+
+// tokenizeExpression splits an expression into operators, parentheses and terms.
+// A sign after an exponent mark of a number is a part of the number, e.g. 1e-3.
+func tokenizeExpression(s string) []string {
+	tokens := []string{}
+	term := strings.Builder{}
+	flush := func() {
+		if term.Len() > 0 {
+			tokens = append(tokens, term.String())
+			term.Reset()
+		}
+	}
+	for _, r := range s {
+		switch {
+		case unicode.IsSpace(r):
+			flush()
+		case (r == '+' || r == '-') && exprMantissaRegex.MatchString(term.String()):
+			term.WriteRune(r)
+		case strings.ContainsRune("+-*/()", r):
+			flush()
+			tokens = append(tokens, string(r))
+		default:
+			term.WriteRune(r)
+		}
+	}
+	flush()
+
+	return tokens
+}
+
+func (p *exprParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+
+	return ""
+}
+
+// parseAdditive parses terms joined by + and -
+func (p *exprParser) parseAdditive() (exprNode, error) {
+	left, err := p.parseMultiplicative()
+	if err != nil {
+		return nil, err
+	}
+	for op := p.peek(); op == "+" || op == "-"; op = p.peek() {
+		p.pos++
+		right, err := p.parseMultiplicative()
+		if err != nil {
+			return nil, err
+		}
+		left = &exprBinary{op: op, left: left, right: right}
+	}
+
+	return left, nil
+}
+
+// parseMultiplicative parses factors joined by * and /
+func (p *exprParser) parseMultiplicative() (exprNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for op := p.peek(); op == "*" || op == "/"; op = p.peek() {
+		p.pos++
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &exprBinary{op: op, left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *exprParser) parseUnary() (exprNode, error) {
+	if p.peek() == "-" {
+		p.pos++
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &exprNegation{operand: operand}, nil
+	}
+
+	return p.parsePrimary()
+}
+
+func (p *exprParser) parsePrimary() (exprNode, error) {
+	token := p.peek()
+	switch token {
+	case "":
+		return nil, errors.New("unexpected end of expression")
+	case "(":
+		p.pos++
+		node, err := p.parseAdditive()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ")" {
+			return nil, errors.New("missing )")
+		}
+		p.pos++
+		return node, nil
+	case ")", "+", "*", "/":
+		return nil, fmt.Errorf("unexpected %q", token)
+	}
+
+	p.pos++
+	if v, err := strconv.ParseFloat(token, 64); err == nil {
+		return exprNumber(v), nil
+	}
+	if exprNumberRegex.MatchString(token) {
+		return nil, fmt.Errorf("invalid number %q", token)
+	}
+	if !exprIndicatorIDRegex.MatchString(token) {
+		return nil, fmt.Errorf("invalid indicator ID %q", token)
+	}
+	if !containsString(p.ids, token) {
+		p.ids = append(p.ids, token)
+	}
+
+	return exprIndicator(token), nil
+}
+
+func (n exprNumber) eval(map[string]*IndicatorValue) (float64, bool) {
+	return float64(n), true
+}
+
+func (n exprNumber) format(func(string) string) string {
+	return strconv.FormatFloat(float64(n), 'g', -1, 64)
+}
+
+func (n exprNumber) precedence() int {
+	return exprPrecedencePrimary
+}
+
+func (n exprIndicator) eval(operands map[string]*IndicatorValue) (float64, bool) {
+	iv, ok := operands[string(n)]
+	if !ok || iv.IsNull() {
+		return 0, false
+	}
+
+	return iv.Value, true
+}
+
+func (n exprIndicator) format(label func(string) string) string {
+	return label(string(n))
+}
+
+func (n exprIndicator) precedence() int {
+	return exprPrecedencePrimary
+}
+
+func (n *exprNegation) eval(operands map[string]*IndicatorValue) (float64, bool) {
+	v, ok := n.operand.eval(operands)
+
+	return -v, ok
+}
+
+func (n *exprNegation) format(label func(string) string) string {
+	s := n.operand.format(label)
+	if n.operand.precedence() < exprPrecedenceUnary {
+		s = "(" + s + ")"
+	}
+
+	return "-" + s
+}
+
+func (n *exprNegation) precedence() int {
+	return exprPrecedenceUnary
+}
+
+func (n *exprBinary) eval(operands map[string]*IndicatorValue) (float64, bool) {
+	left, ok := n.left.eval(operands)
+	if !ok {
+		return 0, false
+	}
+	right, ok := n.right.eval(operands)
+	if !ok {
+		return 0, false
+	}
+
+	switch n.op {
+	case "+":
+		return left + right, true
+	case "-":
+		return left - right, true
+	case "*":
+		return left * right, true
+	default:
+		if right == 0 {
+			return 0, false
+		}
+		return left / right, true
+	}
+}
+
+// format returns the expression with parentheses only where they are needed, e.g. a - (b - c) and a / (b * c)
+func (n *exprBinary) format(label func(string) string) string {
+	left := n.left.format(label)
+	if n.left.precedence() < n.precedence() {
+		left = "(" + left + ")"
+	}
+	right := n.right.format(label)
+	if n.right.precedence() < n.precedence() || (n.right.precedence() == n.precedence() && (n.op == "-" || n.op == "/")) {
+		right = "(" + right + ")"
+	}
+
+	return left + " " + n.op + " " + right
+}
+
+func (n *exprBinary) precedence() int {
+	if n.op == "+" || n.op == "-" {
+		return exprPrecedenceAdditive
+	}
+
+	return exprPrecedenceMultiplicative
+}
@@ -0,0 +1,217 @@
+package wbdata
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/jkkitakita/wbdata-go/testutils"
+)
+
+func TestParseExpression(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    string
+		wantIDs []string
+		wantErr bool
+	}{
+		{
+			name:    "success",
+			s:       "NY.GDP.MKTP.CD / SP.POP.TOTL * 1000",
+			want:    "NY.GDP.MKTP.CD / SP.POP.TOTL * 1000",
+			wantIDs: []string{"NY.GDP.MKTP.CD", "SP.POP.TOTL"},
+		},
+		{
+			name:    "success with parentheses and unary minus",
+			s:       "-(SP.POP.TOTL-SP.POP.0014.TO)/(SP.POP.TOTL*2)+SP.POP.TOTL",
+			want:    "-(SP.POP.TOTL - SP.POP.0014.TO) / (SP.POP.TOTL * 2) + SP.POP.TOTL",
+			wantIDs: []string{"SP.POP.TOTL", "SP.POP.0014.TO"},
+		},
+		{
+			name:    "success with redundant parentheses",
+			s:       "(NY.GDP.MKTP.CD * 2) - (1.5 + 1.0.HCount.1.90usd)",
+			want:    "NY.GDP.MKTP.CD * 2 - (1.5 + 1.0.HCount.1.90usd)",
+			wantIDs: []string{"NY.GDP.MKTP.CD", "1.0.HCount.1.90usd"},
+		},
+		{
+			name:    "success with exponent literals",
+			s:       "NY.GDP.MKTP.CD / SP.POP.TOTL * 1e-3 + 2.5E+2",
+			want:    "NY.GDP.MKTP.CD / SP.POP.TOTL * 0.001 + 250",
+			wantIDs: []string{"NY.GDP.MKTP.CD", "SP.POP.TOTL"},
+		},
+		{
+			name:    "success with unary minus of a number and an indicator",
+			s:       "-1e3 * -SP.POP.TOTL - -2",
+			want:    "-1000 * -SP.POP.TOTL - -2",
+			wantIDs: []string{"SP.POP.TOTL"},
+		},
+		{
+			name:    "failure because empty",
+			s:       " ",
+			wantErr: true,
+		},
+		{
+			name:    "failure because of a missing parenthesis",
+			s:       "(NY.GDP.MKTP.CD / SP.POP.TOTL",
+			wantErr: true,
+		},
+		{
+			name:    "failure because of a missing operand",
+			s:       "NY.GDP.MKTP.CD / * SP.POP.TOTL",
+			wantErr: true,
+		},
+		{
+			name:    "failure because of an exponent without digits",
+			s:       "NY.GDP.MKTP.CD * 1e",
+			wantErr: true,
+		},
+		{
+			name:    "failure because of an invalid indicator ID",
+			s:       "NY.GDP.MKTP.CD * GDP$",
+			wantErr: true,
+		},
+		{
+			name:    "failure because of a missing operator",
+			s:       "NY.GDP.MKTP.CD SP.POP.TOTL",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseExpression(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseExpression() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("Expression.String() = %v, want %v", got.String(), tt.want)
+			}
+			if !reflect.DeepEqual(got.IndicatorIDs, tt.wantIDs) {
+				t.Errorf("ParseExpression() IndicatorIDs = %v, want %v", got.IndicatorIDs, tt.wantIDs)
+			}
+		})
+	}
+}
+
+func TestExpression_Evaluate(t *testing.T) {
+	e, err := ParseExpression("NY.GDP.MKTP.CD / (SP.POP.TOTL - SP.POP.0014.TO)")
+	if err != nil {
+		t.Fatalf("ParseExpression() error = %v", err)
+	}
+	values := []*IndicatorValue{
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2019", 100),
+		newTestValue("SP.POP.TOTL", "JPN", "2019", 30),
+		newTestValue("SP.POP.0014.TO", "JPN", "2019", 10),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2018", 90),
+		newTestNullValue("SP.POP.TOTL", "JPN", "2018"),
+		newTestValue("SP.POP.0014.TO", "JPN", "2018", 10),
+		newTestValue("NY.GDP.MKTP.CD", "USA", "2019", 200),
+		newTestValue("SP.POP.TOTL", "USA", "2019", 10),
+		newTestValue("SP.POP.0014.TO", "USA", "2019", 10),
+		newTestValue("NY.GDP.MKTP.CD", "DEU", "2019", 50),
+		newTestValue("SL.TLF.TOTL.IN", "DEU", "2019", 40),
+	}
+
+	got, err := e.Evaluate(values, &DerivedIndicatorParams{Unit: "US$ per working-age person"})
+	if err != nil {
+		t.Fatalf("Expression.Evaluate() error = %v", err)
+	}
+
+	nan := math.NaN()
+	wantKeys := []string{"DEU 2019", "JPN 2018", "JPN 2019", "USA 2019"}
+	// DEU is missing population, JPN 2018 is null and USA 2019 is divided by zero
+	want := []float64{nan, nan, 5, nan}
+	keys := []string{}
+	gotValues := []float64{}
+	for _, iv := range got {
+		keys = append(keys, iv.Countryiso3code+" "+iv.Date)
+		v := iv.Value
+		if iv.IsNull() {
+			v = nan
+		}
+		gotValues = append(gotValues, v)
+		if iv.Indicator.ID != "NY.GDP.MKTP.CD/(SP.POP.TOTL-SP.POP.0014.TO)" || iv.Unit != "US$ per working-age person" {
+			t.Errorf("Expression.Evaluate() = %+v", iv)
+		}
+	}
+	if !reflect.DeepEqual(keys, wantKeys) {
+		t.Errorf("Expression.Evaluate() keys = %v, want %v", keys, wantKeys)
+	}
+	if !equalValues(gotValues, want) {
+		t.Errorf("Expression.Evaluate() values = %v, want %v", gotValues, want)
+	}
+
+	thousands, err := ParseExpression("NY.GDP.MKTP.CD / 1000")
+	if err != nil {
+		t.Fatalf("ParseExpression() error = %v", err)
+	}
+	gdp := newTestValue("NY.GDP.MKTP.CD", "JPN", "2019", 5000)
+	gdp.Indicator.Value = "GDP (current US$)"
+	got, err = thousands.Evaluate([]*IndicatorValue{gdp}, nil)
+	if err != nil {
+		t.Fatalf("Expression.Evaluate() error = %v", err)
+	}
+	if len(got) != 1 || got[0].Value != 5 || got[0].Unit != "current US$ / 1000" || got[0].Indicator.Value != "GDP (current US$) / 1000" {
+		t.Errorf("Expression.Evaluate() = %+v", got)
+	}
+}
+
+func TestIndicatorValuesService_Derive(t *testing.T) {
+	client, save := NewTestClient(t, *update)
+	defer save()
+
+	i := &IndicatorValuesService{
+		client: client,
+	}
+	params := &DerivedIndicatorParams{
+		CountryIDs: testutils.TestDefaultCountryIDs,
+		FilterParams: &FilterParams{
+			FilterParamsType: FilterParamsDateRange,
+			DateParam: &DateParam{
+				DateRange: &DateRange{
+					Start: testutils.TestDefaultDateStart,
+					End:   testutils.TestDefaultDateEnd,
+				},
+			},
+		},
+		PerPage: testutils.TestDefaultPerPage,
+	}
+
+	got, err := i.Derive("NY.GDP.MKTP.CD / SP.POP.TOTL * 1000", params)
+	if err != nil {
+		t.Fatalf("IndicatorValuesService.Derive() error = %v", err)
+	}
+	if len(got) != 4 {
+		t.Fatalf("IndicatorValuesService.Derive() len = %d, want 4", len(got))
+	}
+
+	japan := got[1]
+	wantIndicator := IDAndValue{
+		ID:    "NY.GDP.MKTP.CD/SP.POP.TOTL*1000",
+		Value: "GDP (current US$) / Population, total * 1000",
+	}
+	if japan.Indicator != wantIndicator || japan.Country.ID != "JP" || japan.Date != "2019" {
+		t.Errorf("IndicatorValuesService.Derive()[1] = %+v", japan)
+	}
+	if want := 5081769542379.77 / 126264931 * 1000; math.Abs(japan.Value-want) > 1e-6 {
+		t.Errorf("IndicatorValuesService.Derive()[1] Value = %v, want %v", japan.Value, want)
+	}
+	// the unit of population is unknown
+	if japan.Unit != "" {
+		t.Errorf("IndicatorValuesService.Derive()[1] Unit = %v, want empty", japan.Unit)
+	}
+	if usa := got[2]; usa.Countryiso3code != "USA" || usa.Date != "2018" || !usa.IsNull() {
+		t.Errorf("IndicatorValuesService.Derive()[2] = %+v", usa)
+	}
+
+	if _, err := i.Derive(testutils.TestInvalidIndicatorID, params); err == nil {
+		t.Errorf("IndicatorValuesService.Derive() error = nil, want error")
+	}
+	if _, err := i.Derive("NY.GDP.MKTP.CD /", params); err == nil {
+		t.Errorf("IndicatorValuesService.Derive() error = nil, want error")
+	}
+}
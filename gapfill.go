@@ -0,0 +1,167 @@
+package wbdata
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// GapFillLinear interpolates gaps linearly between the values around them
+	GapFillLinear GapFillMethod = "linear"
+	// GapFillLogLinear interpolates gaps linearly in logarithms, i.e. at a constant growth rate.
+	// Gaps next to a value which is not positive are not filled.
+	GapFillLogLinear GapFillMethod = "loglinear"
+	// GapFillForward fills gaps with the value before them
+	GapFillForward GapFillMethod = "forward"
+	// GapFillBackward fills gaps with the value after them
+	GapFillBackward GapFillMethod = "backward"
+
+	// ObsStatusImputed is the obs status of values filled by Series.FillGaps
+	ObsStatusImputed = "imputed"
+)
+
+type (
+	// GapFillMethod is a method to fill gaps of a series
+	GapFillMethod string
+
+	// GapFillParams contains parameters for Series.FillGaps
+	GapFillParams struct {
+		Method GapFillMethod
+		// MaxGap is the maximum number of consecutive missing periods to fill. Longer gaps are not filled at all.
+		// 0 means unlimited.
+		MaxGap int
+		// DateRange extends the series to the range, so that leading and trailing periods can be filled
+		// by GapFillBackward and GapFillForward. If it is nil, the series is filled from the first to the last period.
+		DateRange *DateRange
+	}
+)
+
+func (m GapFillMethod) String() string {
+	return string(m)
+}
+
+// IsImputed reports whether the value is filled by Series.FillGaps
+func (iv *IndicatorValue) IsImputed() bool {
+	return iv.ObsStatus == ObsStatusImputed
+}
+
+// FillGaps returns a Series of every period in the range, where null and missing values are filled by the method.
+// Filled values have ObsStatusImputed, and periods which are not filled are null.
+func (s *Series) FillGaps(params *GapFillParams) (*Series, error) {
+	if params == nil {
+		params = &GapFillParams{Method: GapFillLinear}
+	}
+	switch params.Method {
+	case GapFillLinear, GapFillLogLinear, GapFillForward, GapFillBackward:
+	default:
+		return nil, fmt.Errorf("unsupported gap fill method: %s", params.Method)
+	}
+	if params.MaxGap < 0 {
+		return nil, fmt.Errorf("max gap should be 0 or larger, but got %d", params.MaxGap)
+	}
+
+	filled, err := s.regular(params.DateRange)
+	if err != nil {
+		return nil, err
+	}
+
+	values := filled.Values
+	for start := 0; start < len(values); {
+		if !values[start].IsNull() {
+			start++
+			continue
+		}
+		end := start
+		for end < len(values) && values[end].IsNull() {
+			end++
+		}
+		if params.MaxGap == 0 || end-start <= params.MaxGap {
+			fillGap(values, start, end, params.Method)
+		}
+		start = end
+	}
+
+	return filled, nil
+}
+
+// regular returns a Series of every period from the start to the end of dateRange,
+// where missing periods are null values
+func (s *Series) regular(dateRange *DateRange) (*Series, error) {
+	if len(s.Values) == 0 {
+		return s.withValues(nil, nil), nil
+	}
+
+	start, end, err := s.dateRangePeriods(dateRange)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkFrequency(start, end); err != nil {
+		return nil, err
+	}
+
+	regular := s.withValues(nil, nil)
+	template := s.Values[0]
+	for p := start; !end.before(p); p = p.add(1) {
+		iv := &IndicatorValue{
+			Indicator:       template.Indicator,
+			Country:         template.Country,
+			Countryiso3code: template.Countryiso3code,
+			Date:            p.String(),
+			Unit:            template.Unit,
+			Decimal:         template.Decimal,
+			Null:            true,
+		}
+		if i := s.index(p); i >= 0 {
+			iv = s.Values[i]
+		}
+		regular.Values = append(regular.Values, iv)
+		regular.periods = append(regular.periods, p)
+	}
+
+	return regular, nil
+}
+
+// fillGap fills the null values from start to end exclusive, replacing them with imputed copies
+func fillGap(values []*IndicatorValue, start, end int, method GapFillMethod) {
+	var before, after *IndicatorValue
+	if start > 0 {
+		before = values[start-1]
+	}
+	if end < len(values) {
+		after = values[end]
+	}
+
+	for i := start; i < end; i++ {
+		var v float64
+		switch method {
+		case GapFillForward:
+			if before == nil {
+				return
+			}
+			v = before.Value
+		case GapFillBackward:
+			if after == nil {
+				return
+			}
+			v = after.Value
+		case GapFillLinear:
+			if before == nil || after == nil {
+				return
+			}
+			w := float64(i-start+1) / float64(end-start+1)
+			v = before.Value + (after.Value-before.Value)*w
+		case GapFillLogLinear:
+			if before == nil || after == nil || before.Value <= 0 || after.Value <= 0 {
+				return
+			}
+			w := float64(i-start+1) / float64(end-start+1)
+			v = math.Exp(math.Log(before.Value) + (math.Log(after.Value)-math.Log(before.Value))*w)
+		}
+
+		imputed := *values[i]
+		imputed.Value = v
+		imputed.ObsStatus = ObsStatusImputed
+		imputed.Null = false
+		values[i] = &imputed
+	}
+}
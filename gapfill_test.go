@@ -0,0 +1,102 @@
+package wbdata
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestSeries_FillGaps(t *testing.T) {
+	nan := math.NaN()
+	// 2016 is null, 2017 is missing and 2019 to 2021 are missing
+	s, err := NewSeries([]*IndicatorValue{
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2015", 100),
+		newTestNullValue("NY.GDP.MKTP.CD", "JPN", "2016"),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2018", 400),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2022", 800),
+	})
+	if err != nil {
+		t.Fatalf("NewSeries() error = %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		params    *GapFillParams
+		wantDates []string
+		want      []float64
+		wantErr   bool
+	}{
+		{
+			name:      "linear",
+			params:    &GapFillParams{Method: GapFillLinear},
+			wantDates: []string{"2015", "2016", "2017", "2018", "2019", "2020", "2021", "2022"},
+			want:      []float64{100, 200, 300, 400, 500, 600, 700, 800},
+		},
+		{
+			name:      "linear with max gap",
+			params:    &GapFillParams{Method: GapFillLinear, MaxGap: 2},
+			wantDates: []string{"2015", "2016", "2017", "2018", "2019", "2020", "2021", "2022"},
+			want:      []float64{100, 200, 300, 400, nan, nan, nan, 800},
+		},
+		{
+			name:      "log-linear",
+			params:    &GapFillParams{Method: GapFillLogLinear, MaxGap: 2},
+			wantDates: []string{"2015", "2016", "2017", "2018", "2019", "2020", "2021", "2022"},
+			want:      []float64{100, 100 * math.Pow(4, 1.0/3), 100 * math.Pow(4, 2.0/3), 400, nan, nan, nan, 800},
+		},
+		{
+			name:      "forward with date range",
+			params:    &GapFillParams{Method: GapFillForward, DateRange: &DateRange{Start: "2014", End: "2023"}},
+			wantDates: []string{"2014", "2015", "2016", "2017", "2018", "2019", "2020", "2021", "2022", "2023"},
+			want:      []float64{nan, 100, 100, 100, 400, 400, 400, 400, 800, 800},
+		},
+		{
+			name:      "backward with date range",
+			params:    &GapFillParams{Method: GapFillBackward, DateRange: &DateRange{Start: "2014", End: "2023"}},
+			wantDates: []string{"2014", "2015", "2016", "2017", "2018", "2019", "2020", "2021", "2022", "2023"},
+			want:      []float64{100, 100, 400, 400, 400, 800, 800, 800, 800, nan},
+		},
+		{
+			name:    "failure because of an unsupported method",
+			params:  &GapFillParams{Method: "invalid_method"},
+			wantErr: true,
+		},
+		{
+			name:    "failure because of a date range of another frequency",
+			params:  &GapFillParams{Method: GapFillLinear, DateRange: &DateRange{Start: "2014Q1"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.FillGaps(tt.params)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Series.FillGaps() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if dates := got.Dates(); !reflect.DeepEqual(dates, tt.wantDates) {
+				t.Errorf("Series.FillGaps() dates = %v, want %v", dates, tt.wantDates)
+			}
+			if values := seriesValues(got); !equalValues(values, tt.want) {
+				t.Errorf("Series.FillGaps() values = %v, want %v", values, tt.want)
+			}
+		})
+	}
+
+	got, err := s.FillGaps(nil)
+	if err != nil {
+		t.Fatalf("Series.FillGaps() error = %v", err)
+	}
+	if got.Values[0] != s.Values[0] || got.Values[0].IsImputed() {
+		t.Errorf("Series.FillGaps() should keep the reported value, got %+v", got.Values[0])
+	}
+	if iv := got.Values[1]; !iv.IsImputed() || iv.Indicator.ID != "NY.GDP.MKTP.CD" || iv.Countryiso3code != "JPN" {
+		t.Errorf("Series.FillGaps() should impute 2016, got %+v", iv)
+	}
+	if !s.Values[1].IsNull() || s.Values[1].IsImputed() {
+		t.Errorf("Series.FillGaps() should not modify the series, got %+v", s.Values[1])
+	}
+}
@@ -0,0 +1,191 @@
+package wbdata
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+)
+
+const (
+	geoJSONTypeFeatureCollection = "FeatureCollection"
+	geoJSONTypeFeature           = "Feature"
+	geoJSONTypePoint             = "Point"
+)
+
+type (
+	// GeoJSONParams contains parameters for GeoJSON
+	GeoJSONParams struct {
+		// Date is the date of indicator values set to properties
+		Date string
+		// WithTimeSeries embeds all indicator values of a feature as time series
+		WithTimeSeries bool
+	}
+
+	// GeoJSONFeatureCollection is a GeoJSON FeatureCollection
+	GeoJSONFeatureCollection struct {
+		Type     string            `json:"type"`
+		Features []*GeoJSONFeature `json:"features"`
+	}
+
+	// GeoJSONFeature is a GeoJSON Feature of a country
+	GeoJSONFeature struct {
+		Type       string                 `json:"type"`
+		ID         string                 `json:"id"`
+		Geometry   *GeoJSONPoint          `json:"geometry"`
+		Properties map[string]interface{} `json:"properties"`
+	}
+
+	// GeoJSONPoint is a GeoJSON Point geometry of the capital city
+	GeoJSONPoint struct {
+		Type        string     `json:"type"`
+		Coordinates [2]float64 `json:"coordinates"`
+	}
+
+	// GeoJSONObservation is an observation of a time series in properties
+	GeoJSONObservation struct {
+		Date  string   `json:"date"`
+		Value *float64 `json:"value"`
+	}
+)
+
+// NewGeoJSONFeatureCollection returns a FeatureCollection with a point feature per country.
+// Properties hold the country attributes and the indicator values for params.Date keyed by indicator ID.
+// Countries without coordinates such as aggregates are skipped.
+func NewGeoJSONFeatureCollection(
+	countries []*Country,
+	indicatorValues []*IndicatorValue,
+	params *GeoJSONParams,
+) (*GeoJSONFeatureCollection, error) {
+	if params == nil || params.Date == "" {
+		return nil, errors.New("date of geojson params is required")
+	}
+	if _, err := parsePeriod(params.Date); err != nil {
+		return nil, err
+	}
+
+	indicatorIDs := []string{}
+	seenIndicatorIDs := map[string]bool{}
+	valuesByCountry := map[string][]*IndicatorValue{}
+	for _, iv := range indicatorValues {
+		if !seenIndicatorIDs[iv.Indicator.ID] {
+			seenIndicatorIDs[iv.Indicator.ID] = true
+			indicatorIDs = append(indicatorIDs, iv.Indicator.ID)
+		}
+		valuesByCountry[iv.countryCode()] = append(valuesByCountry[iv.countryCode()], iv)
+	}
+
+	fc := &GeoJSONFeatureCollection{
+		Type:     geoJSONTypeFeatureCollection,
+		Features: []*GeoJSONFeature{},
+	}
+	for _, country := range countries {
+		point, ok := country.geoJSONPoint()
+		if !ok {
+			continue
+		}
+
+		values := append([]*IndicatorValue{}, valuesByCountry[country.ID]...)
+		if country.Iso2Code != country.ID {
+			values = append(values, valuesByCountry[country.Iso2Code]...)
+		}
+		properties := map[string]interface{}{
+			"name":        country.Name,
+			"iso2Code":    country.Iso2Code,
+			"capitalCity": country.CapitalCity,
+			"region":      country.Region.ID,
+			"adminRegion": country.AdminRegion.ID,
+			"incomeLevel": country.IncomeLevel.ID,
+			"lendingType": country.LendingType.ID,
+			"date":        params.Date,
+		}
+		for _, indicatorID := range indicatorIDs {
+			properties[indicatorID] = nil
+		}
+		for _, iv := range values {
+			if iv.Date == params.Date {
+				properties[iv.Indicator.ID] = iv.nullableValue()
+			}
+		}
+		if params.WithTimeSeries {
+			timeSeries, err := newGeoJSONTimeSeries(indicatorIDs, values)
+			if err != nil {
+				return nil, err
+			}
+			properties["timeSeries"] = timeSeries
+		}
+
+		fc.Features = append(fc.Features, &GeoJSONFeature{
+			Type:       geoJSONTypeFeature,
+			ID:         country.ID,
+			Geometry:   point,
+			Properties: properties,
+		})
+	}
+
+	return fc, nil
+}
+
+// WriteGeoJSON writes a FeatureCollection returned by NewGeoJSONFeatureCollection to w
+func WriteGeoJSON(w io.Writer, countries []*Country, indicatorValues []*IndicatorValue, params *GeoJSONParams) error {
+	fc, err := NewGeoJSONFeatureCollection(countries, indicatorValues, params)
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(fc); err != nil {
+		return fmt.Errorf("failed to encode geojson: %v", err)
+	}
+
+	return nil
+}
+
+// geoJSONPoint returns a point of the capital city, or false if the country has no coordinates
+func (c *Country) geoJSONPoint() (*GeoJSONPoint, bool) {
+	longitude, err := strconv.ParseFloat(c.Longitude, 64)
+	if err != nil {
+		return nil, false
+	}
+	latitude, err := strconv.ParseFloat(c.Latitude, 64)
+	if err != nil {
+		return nil, false
+	}
+
+	return &GeoJSONPoint{
+		Type:        geoJSONTypePoint,
+		Coordinates: [2]float64{longitude, latitude},
+	}, true
+}
+
+// newGeoJSONTimeSeries returns observations by indicator ID sorted by date
+func newGeoJSONTimeSeries(indicatorIDs []string, values []*IndicatorValue) (map[string][]*GeoJSONObservation, error) {
+	periods := make(map[*IndicatorValue]period, len(values))
+	for _, iv := range values {
+		p, err := parsePeriod(iv.Date)
+		if err != nil {
+			return nil, err
+		}
+		periods[iv] = p
+	}
+	sorted := append([]*IndicatorValue{}, values...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return periods[sorted[i]].before(periods[sorted[j]])
+	})
+
+	timeSeries := make(map[string][]*GeoJSONObservation, len(indicatorIDs))
+	for _, indicatorID := range indicatorIDs {
+		timeSeries[indicatorID] = []*GeoJSONObservation{}
+	}
+	for _, iv := range sorted {
+		timeSeries[iv.Indicator.ID] = append(timeSeries[iv.Indicator.ID], &GeoJSONObservation{
+			Date:  iv.Date,
+			Value: iv.nullableValue(),
+		})
+	}
+
+	return timeSeries, nil
+}
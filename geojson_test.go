@@ -0,0 +1,125 @@
+package wbdata
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestNewGeoJSONFeatureCollection(t *testing.T) {
+	countries := []*Country{
+		{
+			ID:          "JPN",
+			Name:        "Japan",
+			CapitalCity: "Tokyo",
+			Iso2Code:    "JP",
+			Longitude:   "139.77",
+			Latitude:    "35.67",
+			Region:      CountryRegion{ID: "EAS"},
+			IncomeLevel: IncomeLevel{ID: "HIC"},
+			LendingType: LendingType{ID: "LNX"},
+		},
+		{
+			ID:       "ARB",
+			Name:     "Arab World",
+			Iso2Code: "1A",
+			Region:   CountryRegion{ID: "NA"},
+		},
+	}
+	indicatorValues := newTestIndicatorValues()
+
+	type args struct {
+		params *GeoJSONParams
+	}
+	tests := []struct {
+		name           string
+		args           args
+		wantValue      interface{}
+		wantTimeSeries interface{}
+		wantErr        bool
+	}{
+		{
+			name: "success",
+			args: args{
+				params: &GeoJSONParams{Date: "2019"},
+			},
+			wantValue:      5.08176954237977e+12,
+			wantTimeSeries: nil,
+			wantErr:        false,
+		},
+		{
+			name: "success with null value and time series",
+			args: args{
+				params: &GeoJSONParams{Date: "2020", WithTimeSeries: true},
+			},
+			wantValue: nil,
+			wantTimeSeries: map[string]interface{}{
+				"NY.GDP.MKTP.CD": []interface{}{
+					map[string]interface{}{"date": "2019", "value": 5.08176954237977e+12},
+					map[string]interface{}{"date": "2020", "value": nil},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "failure because date is empty",
+			args: args{
+				params: &GeoJSONParams{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "failure because invalid date",
+			args: args{
+				params: &GeoJSONParams{Date: "invalid_date"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := WriteGeoJSON(&buf, countries, indicatorValues, tt.args.params)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("WriteGeoJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+
+			got := struct {
+				Type     string
+				Features []struct {
+					ID       string
+					Geometry struct {
+						Type        string
+						Coordinates []float64
+					}
+					Properties map[string]interface{}
+				}
+			}{}
+			if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+
+			if got.Type != "FeatureCollection" || len(got.Features) != 1 {
+				t.Fatalf("WriteGeoJSON() = %+v, want a feature collection without aggregates", got)
+			}
+			feature := got.Features[0]
+			if feature.ID != "JPN" || !reflect.DeepEqual(feature.Geometry.Coordinates, []float64{139.77, 35.67}) {
+				t.Errorf("WriteGeoJSON() feature = %+v", feature)
+			}
+			if feature.Properties["region"] != "EAS" || feature.Properties["incomeLevel"] != "HIC" {
+				t.Errorf("WriteGeoJSON() properties = %v", feature.Properties)
+			}
+			if got := feature.Properties["NY.GDP.MKTP.CD"]; got != tt.wantValue {
+				t.Errorf("WriteGeoJSON() value = %v, want %v", got, tt.wantValue)
+			}
+			if got := feature.Properties["timeSeries"]; !reflect.DeepEqual(got, tt.wantTimeSeries) {
+				t.Errorf("WriteGeoJSON() time series = %v, want %v", got, tt.wantTimeSeries)
+			}
+		})
+	}
+}
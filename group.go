@@ -0,0 +1,314 @@
+package wbdata
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+)
+
+const (
+	// GroupTypeRegion is the type of geographic regions, which are Region of Country
+	GroupTypeRegion GroupType = "region"
+	// GroupTypeAdminRegion is the type of administrative regions, which are AdminRegion of Country
+	GroupTypeAdminRegion GroupType = "adminregion"
+	// GroupTypeIncomeLevel is the type of income levels
+	GroupTypeIncomeLevel GroupType = "incomelevel"
+	// GroupTypeLendingType is the type of lending types
+	GroupTypeLendingType GroupType = "lendingtype"
+	// GroupTypeOther is the type of the other regions, e.g. Arab World and Euro area
+	GroupTypeOther GroupType = "other"
+)
+
+type (
+	// GroupType is the type of a group of countries
+	GroupType string
+
+	// Group is a group of countries, i.e. a region, an income level or a lending type
+	Group struct {
+		ID   string
+		Name string
+		// Types are the types of the group. A region can be both geographic and administrative, e.g. SAS.
+		Types []GroupType
+		// Members are the IDs of the countries in the group, ordered by ID
+		Members []string
+	}
+
+	// GroupGraph is the graph of groups and countries.
+	// It can be cached by Save and LoadGroupGraph because building it requests the API many times.
+	GroupGraph struct {
+		Countries []*Country
+		Groups    []*Group
+
+		countries map[string]*Country
+		groups    map[string]*Group
+		memberOf  map[string][]*Group
+	}
+)
+
+func (t GroupType) String() string {
+	return string(t)
+}
+
+// BuildGroupGraph returns a GroupGraph built from List with each region, income level and lending type filter.
+// Every list is requested perPage items at a time.
+func (c *CountriesService) BuildGroupGraph(perPage int) (*GroupGraph, error) {
+	countries, err := c.listAll(nil, perPage)
+	if err != nil {
+		return nil, err
+	}
+
+	graph := &GroupGraph{Countries: countries}
+	regionTypes := map[string][]GroupType{}
+	for _, country := range countries {
+		if id := country.Region.ID; id != "" && !country.IsAggregate() {
+			regionTypes[id] = appendGroupType(regionTypes[id], GroupTypeRegion)
+		}
+		if id := country.AdminRegion.ID; id != "" {
+			regionTypes[id] = appendGroupType(regionTypes[id], GroupTypeAdminRegion)
+		}
+	}
+
+	regions := []*Region{}
+	err = forEachPage(perPage, func(pages *PageParams) (*PageSummary, error) {
+		summary, rs, err := c.client.Regions.List(pages)
+		regions = append(regions, rs...)
+		return summary, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range regions {
+		types := regionTypes[r.Code]
+		if len(types) == 0 {
+			types = []GroupType{GroupTypeOther}
+		}
+		if err := graph.addGroup(c, r.Code, r.Name, types, &ListCountryParams{RegionID: r.Code}, perPage); err != nil {
+			return nil, err
+		}
+	}
+
+	incomeLevels := []*IncomeLevel{}
+	err = forEachPage(perPage, func(pages *PageParams) (*PageSummary, error) {
+		summary, ils, err := c.client.IncomeLevels.List(pages)
+		incomeLevels = append(incomeLevels, ils...)
+		return summary, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, il := range incomeLevels {
+		types := []GroupType{GroupTypeIncomeLevel}
+		if err := graph.addGroup(c, il.ID, il.Value, types, &ListCountryParams{IncomeLevelID: il.ID}, perPage); err != nil {
+			return nil, err
+		}
+	}
+
+	lendingTypes := []*LendingType{}
+	err = forEachPage(perPage, func(pages *PageParams) (*PageSummary, error) {
+		summary, lts, err := c.client.LendingTypes.List(pages)
+		lendingTypes = append(lendingTypes, lts...)
+		return summary, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, lt := range lendingTypes {
+		types := []GroupType{GroupTypeLendingType}
+		if err := graph.addGroup(c, lt.ID, lt.Value, types, &ListCountryParams{LendingTypeID: lt.ID}, perPage); err != nil {
+			return nil, err
+		}
+	}
+
+	graph.buildIndexes()
+
+	return graph, nil
+}
+
+// LoadGroupGraph reads a GroupGraph written by GroupGraph.Save
+func LoadGroupGraph(r io.Reader) (*GroupGraph, error) {
+	graph := &GroupGraph{}
+	if err := json.NewDecoder(r).Decode(graph); err != nil {
+		return nil, fmt.Errorf("failed to decode group graph: %v", err)
+	}
+	graph.buildIndexes()
+
+	return graph, nil
+}
+
+// Save writes the graph to w as JSON, which can be read by LoadGroupGraph
+func (g *GroupGraph) Save(w io.Writer) error {
+	if err := json.NewEncoder(w).Encode(g); err != nil {
+		return fmt.Errorf("failed to encode group graph: %v", err)
+	}
+
+	return nil
+}
+
+// Country returns the country of id. It returns nil if the graph has no such country.
+func (g *GroupGraph) Country(id string) *Country {
+	return g.countries[id]
+}
+
+// Group returns the group of id. It returns nil if the graph has no such group.
+func (g *GroupGraph) Group(id string) *Group {
+	return g.groups[id]
+}
+
+// Members returns the countries in the group. It returns nil if the graph has no such group.
+func (g *GroupGraph) Members(groupID string) []*Country {
+	group := g.groups[groupID]
+	if group == nil {
+		return nil
+	}
+
+	members := make([]*Country, 0, len(group.Members))
+	for _, id := range group.Members {
+		if country := g.countries[id]; country != nil {
+			members = append(members, country)
+		}
+	}
+
+	return members
+}
+
+// GroupsOf returns the groups which contain the country, ordered by ID.
+// If types are specified, only groups of the types are returned.
+func (g *GroupGraph) GroupsOf(countryID string, types ...GroupType) []*Group {
+	groups := []*Group{}
+	for _, group := range g.memberOf[countryID] {
+		if len(types) == 0 || group.hasAnyType(types) {
+			groups = append(groups, group)
+		}
+	}
+
+	return groups
+}
+
+// IsAggregate reports whether id is a group or an aggregate of the Countries API, e.g. EAS and WLD
+func (g *GroupGraph) IsAggregate(id string) bool {
+	if _, ok := g.groups[id]; ok {
+		return true
+	}
+	country := g.countries[id]
+
+	return country != nil && country.IsAggregate()
+}
+
+// IsEconomy reports whether id is a country which is not an aggregate
+func (g *GroupGraph) IsEconomy(id string) bool {
+	_, ok := g.countries[id]
+
+	return ok && !g.IsAggregate(id)
+}
+
+// HasType reports whether the group is of the type
+func (group *Group) HasType(t GroupType) bool {
+	for _, gt := range group.Types {
+		if gt == t {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (group *Group) hasAnyType(types []GroupType) bool {
+	for _, t := range types {
+		if group.HasType(t) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (g *GroupGraph) addGroup(
+	c *CountriesService,
+	id string,
+	name string,
+	types []GroupType,
+	params *ListCountryParams,
+	perPage int,
+) error {
+	if id == "" {
+		return nil
+	}
+
+	countries, err := c.listAll(params, perPage)
+	if err != nil {
+		return fmt.Errorf("failed to list countries of %s: %v", id, err)
+	}
+
+	members := make([]string, 0, len(countries))
+	for _, country := range countries {
+		members = append(members, country.ID)
+	}
+	sort.Strings(members)
+
+	g.Groups = append(g.Groups, &Group{
+		ID:      id,
+		Name:    name,
+		Types:   types,
+		Members: members,
+	})
+
+	return nil
+}
+
+func (g *GroupGraph) buildIndexes() {
+	g.countries = map[string]*Country{}
+	for _, country := range g.Countries {
+		g.countries[country.ID] = country
+	}
+
+	g.groups = map[string]*Group{}
+	g.memberOf = map[string][]*Group{}
+	for _, group := range g.Groups {
+		g.groups[group.ID] = group
+		for _, id := range group.Members {
+			g.memberOf[id] = append(g.memberOf[id], group)
+		}
+	}
+	for _, groups := range g.memberOf {
+		groups := groups
+		sort.SliceStable(groups, func(i, j int) bool {
+			return groups[i].ID < groups[j].ID
+		})
+	}
+}
+
+// listAll returns the countries of all pages
+func (c *CountriesService) listAll(params *ListCountryParams, perPage int) ([]*Country, error) {
+	countries := []*Country{}
+	err := forEachPage(perPage, func(pages *PageParams) (*PageSummary, error) {
+		summary, cs, err := c.List(params, pages)
+		countries = append(countries, cs...)
+		return summary, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return countries, nil
+}
+
+func appendGroupType(types []GroupType, t GroupType) []GroupType {
+	for _, gt := range types {
+		if gt == t {
+			return types
+		}
+	}
+
+	return append(types, t)
+}
+
+// AggregateIDs returns the IDs of the groups and the aggregates in the graph
+func (g *GroupGraph) AggregateIDs() AggregateIDs {
+	aggregates := NewAggregateIDs(g.Countries)
+	for _, group := range g.Groups {
+		aggregates[group.ID] = true
+	}
+
+	return aggregates
+}
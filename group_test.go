@@ -0,0 +1,86 @@
+package wbdata
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func groupIDs(groups []*Group) []string {
+	ids := []string{}
+	for _, g := range groups {
+		ids = append(ids, g.ID)
+	}
+
+	return ids
+}
+
+func TestCountriesService_BuildGroupGraph(t *testing.T) {
+	client, save := NewTestClient(t, *update)
+	defer save()
+
+	c := &CountriesService{
+		client: client,
+	}
+	graph, err := c.BuildGroupGraph(50)
+	if err != nil {
+		t.Fatalf("CountriesService.BuildGroupGraph() error = %v", err)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := graph.Save(buf); err != nil {
+		t.Fatalf("GroupGraph.Save() error = %v", err)
+	}
+	loaded, err := LoadGroupGraph(buf)
+	if err != nil {
+		t.Fatalf("LoadGroupGraph() error = %v", err)
+	}
+
+	for name, g := range map[string]*GroupGraph{"built": graph, "loaded": loaded} {
+		t.Run(name, func(t *testing.T) {
+			members := []string{}
+			for _, country := range g.Members("EAS") {
+				members = append(members, country.ID)
+			}
+			if want := []string{"CHN", "JPN"}; !reflect.DeepEqual(members, want) {
+				t.Errorf("GroupGraph.Members() = %v, want %v", members, want)
+			}
+			if got := g.Members("invalid_region_id"); got != nil {
+				t.Errorf("GroupGraph.Members() = %v, want nil", got)
+			}
+
+			if got, want := groupIDs(g.GroupsOf("JPN")), []string{"EAS", "HIC", "LNX", "OED"}; !reflect.DeepEqual(got, want) {
+				t.Errorf("GroupGraph.GroupsOf() = %v, want %v", got, want)
+			}
+			got := groupIDs(g.GroupsOf("CHN", GroupTypeAdminRegion, GroupTypeIncomeLevel))
+			if want := []string{"EAP", "UMC"}; !reflect.DeepEqual(got, want) {
+				t.Errorf("GroupGraph.GroupsOf() = %v, want %v", got, want)
+			}
+
+			sas := g.Group("SAS")
+			if sas == nil || !sas.HasType(GroupTypeRegion) || !sas.HasType(GroupTypeAdminRegion) {
+				t.Errorf("GroupGraph.Group() = %+v, want both geographic and admin region", sas)
+			}
+			if oed := g.Group("OED"); oed == nil || !reflect.DeepEqual(oed.Types, []GroupType{GroupTypeOther}) {
+				t.Errorf("GroupGraph.Group() = %+v, want other region", oed)
+			}
+
+			for id, want := range map[string]bool{"EAS": true, "WLD": true, "HIC": true, "JPN": false, "invalid": false} {
+				if got := g.IsAggregate(id); got != want {
+					t.Errorf("GroupGraph.IsAggregate(%s) = %v, want %v", id, got, want)
+				}
+			}
+			aggregates := g.AggregateIDs()
+			for id, want := range map[string]bool{"EAS": true, "1W": true, "OED": true, "JPN": false} {
+				if got := aggregates[id]; got != want {
+					t.Errorf("GroupGraph.AggregateIDs()[%s] = %v, want %v", id, got, want)
+				}
+			}
+			for id, want := range map[string]bool{"EAS": false, "WLD": false, "JPN": true, "invalid": false} {
+				if got := g.IsEconomy(id); got != want {
+					t.Errorf("GroupGraph.IsEconomy(%s) = %v, want %v", id, got, want)
+				}
+			}
+		})
+	}
+}
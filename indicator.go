@@ -19,6 +19,15 @@ type (
 		Topics             []*IDAndValue
 	}
 
+	// IndicatorIterator iterates Indicators page by page
+	IndicatorIterator struct {
+		list    func(pages *PageParams) (*PageSummary, []*Indicator, error)
+		pager   *pager
+		buf     []*Indicator
+		current *Indicator
+		err     error
+	}
+
 	// IDAndValue represents ID and Value
 	IDAndValue struct {
 		ID    string
@@ -86,3 +95,115 @@ func (i *IndicatorsService) ListByTopicID(topicID string, pages *PageParams) (*P
 
 	return summary, indicators, nil
 }
+
+// ListBySourceID returns a Response's Summary and Indicators By source id
+func (i *IndicatorsService) ListBySourceID(sourceID string, pages *PageParams) (*PageSummary, []*Indicator, error) {
+	summary := &PageSummary{}
+	indicators := []*Indicator{}
+
+	path := fmt.Sprintf("sources/%s/indicators", sourceID)
+	req, err := i.client.NewRequest("GET", path, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := pages.addPageParams(req); err != nil {
+		return nil, nil, err
+	}
+
+	if err = i.client.do(req, &[]interface{}{summary, &indicators}); err != nil {
+		return nil, nil, err
+	}
+
+	return summary, indicators, nil
+}
+
+// Iterate returns an IndicatorIterator over all Indicators.
+// The iterator requests the next page with perPage indicators when the current page is consumed.
+func (i *IndicatorsService) Iterate(perPage int) *IndicatorIterator {
+	return &IndicatorIterator{
+		list:  i.List,
+		pager: newPager(perPage),
+	}
+}
+
+// IterateBySourceID returns an IndicatorIterator over all Indicators of a source.
+// The iterator requests the next page with perPage indicators when the current page is consumed.
+func (i *IndicatorsService) IterateBySourceID(sourceID string, perPage int) *IndicatorIterator {
+	return &IndicatorIterator{
+		list: func(pages *PageParams) (*PageSummary, []*Indicator, error) {
+			return i.ListBySourceID(sourceID, pages)
+		},
+		pager: newPager(perPage),
+	}
+}
+
+// Next advances the iterator to the next Indicator, which is available through Indicator.
+// It returns false when the iteration stops, either by reaching the end or an error.
+func (it *IndicatorIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	for len(it.buf) == 0 {
+		var indicators []*Indicator
+		ok, err := it.pager.next(func(pages *PageParams) (*PageSummary, error) {
+			summary, page, err := it.list(pages)
+			indicators = page
+			return summary, err
+		})
+		if err != nil {
+			it.err = err
+			it.current = nil
+			return false
+		}
+		if !ok || len(indicators) == 0 {
+			it.current = nil
+			return false
+		}
+		it.buf = indicators
+	}
+
+	it.current = it.buf[0]
+	it.buf = it.buf[1:]
+
+	return true
+}
+
+// Indicator returns the current Indicator
+func (it *IndicatorIterator) Indicator() *Indicator {
+	return it.current
+}
+
+// Summary returns the Response's Summary of the last requested page
+func (it *IndicatorIterator) Summary() *PageSummary {
+	return it.pager.summary
+}
+
+// Err returns the first error that occurred during the iteration
+func (it *IndicatorIterator) Err() error {
+	return it.err
+}
+
+// SourceID returns the source ID of the indicator
+func (ind *Indicator) SourceID() string {
+	if ind.Source == nil {
+		return ""
+	}
+
+	return ind.Source.ID
+}
+
+// TopicIDs returns the topic IDs of the indicator.
+// The API returns an empty object as a topic of an indicator without topics, which is skipped.
+func (ind *Indicator) TopicIDs() []string {
+	ids := []string{}
+	for _, t := range ind.Topics {
+		if t == nil || t.ID == "" {
+			continue
+		}
+		ids = append(ids, t.ID)
+	}
+
+	return ids
+}
@@ -327,3 +327,191 @@ func TestIndicatorsService_ListByTopicID(t *testing.T) {
 		})
 	}
 }
+
+func TestIndicatorsService_ListBySourceID(t *testing.T) {
+	client, save := NewTestClient(t, *update)
+	defer save()
+
+	defaultPageParams := &PageParams{
+		Page:    testutils.TestDefaultPage,
+		PerPage: testutils.TestDefaultPerPage,
+	}
+	invalidPageParams := &PageParams{
+		Page:    testutils.TestInvalidPage,
+		PerPage: testutils.TestDefaultPerPage,
+	}
+
+	type args struct {
+		sourceID string
+		pages    *PageParams
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *PageSummary
+		want1   []*Indicator
+		wantErr bool
+	}{
+		{
+			name: "success",
+			args: args{
+				sourceID: "11",
+				pages:    defaultPageParams,
+			},
+			want: &PageSummary{
+				Page:    intOrString(testutils.TestDefaultPage),
+				PerPage: intOrString(testutils.TestDefaultPerPage),
+			},
+			want1: []*Indicator{
+				{
+					ID:   "AG.AGR.TRAC.NO",
+					Name: "Agricultural machinery, tractors",
+					Source: &IDAndValue{
+						ID:    "11",
+						Value: "Africa Development Indicators",
+					},
+					Topics: []*IDAndValue{
+						{
+							ID:    "1",
+							Value: "Agriculture & Rural Development  ",
+						},
+					},
+				},
+				{
+					ID:   "EG.ELC.ACCS.ZS",
+					Name: "Access to electricity (% of population)",
+					Source: &IDAndValue{
+						ID:    "11",
+						Value: "Africa Development Indicators",
+					},
+					Topics: []*IDAndValue{
+						{
+							ID:    "6",
+							Value: "Environment ",
+						},
+						{
+							ID:    "19",
+							Value: "Climate Change",
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "failure because invalid source id",
+			args: args{
+				sourceID: testutils.TestInvalidSourceID,
+				pages:    defaultPageParams,
+			},
+			want:    nil,
+			want1:   nil,
+			wantErr: true,
+		},
+		{
+			name: "failure because Page is less than 1",
+			args: args{
+				sourceID: "11",
+				pages:    invalidPageParams,
+			},
+			want:    nil,
+			want1:   nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := &IndicatorsService{
+				client: client,
+			}
+			got, got1, err := i.ListBySourceID(tt.args.sourceID, tt.args.pages)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IndicatorsService.ListBySourceID() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.want != nil && (got.Page != tt.want.Page || got.PerPage != tt.want.PerPage) {
+				t.Errorf("IndicatorsService.ListBySourceID() got = %v, want %v", got, tt.want)
+			}
+			if tt.want1 != nil && !reflect.DeepEqual(got1, tt.want1) {
+				t.Errorf("IndicatorsService.ListBySourceID() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
+func TestIndicatorIterator_Next(t *testing.T) {
+	client, save := NewTestClient(t, *update)
+	defer save()
+
+	tests := []struct {
+		name     string
+		sourceID string
+		want     []string
+		wantErr  bool
+	}{
+		{
+			name:     "success",
+			sourceID: "11",
+			want:     []string{"AG.AGR.TRAC.NO", "EG.ELC.ACCS.ZS", "IC.BUS.EASE.XQ"},
+			wantErr:  false,
+		},
+		{
+			name:     "failure because invalid source id",
+			sourceID: testutils.TestInvalidSourceID,
+			want:     []string{},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := &IndicatorsService{
+				client: client,
+			}
+			it := i.IterateBySourceID(tt.sourceID, testutils.TestDefaultPerPage)
+			got := []string{}
+			for it.Next() {
+				got = append(got, it.Indicator().ID)
+			}
+			if (it.Err() != nil) != tt.wantErr {
+				t.Errorf("IndicatorIterator.Err() error = %v, wantErr %v", it.Err(), tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("IndicatorIterator.Next() got = %v, want %v", got, tt.want)
+			}
+			if it.Next() {
+				t.Errorf("IndicatorIterator.Next() = true after the end")
+			}
+		})
+	}
+}
+
+func TestIndicator_TopicIDs(t *testing.T) {
+	tests := []struct {
+		name      string
+		indicator *Indicator
+		want      []string
+	}{
+		{
+			name: "success",
+			indicator: &Indicator{
+				Topics: []*IDAndValue{{ID: "6"}, {ID: "19"}},
+			},
+			want: []string{"6", "19"},
+		},
+		{
+			name: "empty topic",
+			indicator: &Indicator{
+				Topics: []*IDAndValue{{}},
+			},
+			want: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.indicator.TopicIDs(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Indicator.TopicIDs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package wbdata
+
+import (
+	"sort"
+	"strings"
+)
+
+type (
+	// IndicatorTree is a tree of sources, topics and indicators built from the relations of Indicators
+	IndicatorTree struct {
+		Sources []*IndicatorSourceNode
+	}
+
+	// IndicatorSourceNode is a source and its topics in IndicatorTree
+	IndicatorSourceNode struct {
+		Source IDAndValue
+		Topics []*IndicatorTopicNode
+	}
+
+	// IndicatorTopicNode is a topic and its indicators in IndicatorTree.
+	// Indicators without topics belong to the node whose Topic.ID is empty.
+	IndicatorTopicNode struct {
+		Topic      IDAndValue
+		Indicators []*Indicator
+	}
+)
+
+// NewIndicatorTree returns an IndicatorTree of indicators.
+// An indicator with several topics belongs to all of them. Sources and topics are ordered by ID.
+func NewIndicatorTree(indicators []*Indicator) *IndicatorTree {
+	tree := &IndicatorTree{}
+	sources := map[string]*IndicatorSourceNode{}
+	topics := map[string]map[string]*IndicatorTopicNode{}
+
+	for _, ind := range indicators {
+		sourceID := ind.SourceID()
+		sn, ok := sources[sourceID]
+		if !ok {
+			sn = &IndicatorSourceNode{}
+			if ind.Source != nil {
+				sn.Source = *ind.Source
+			}
+			sources[sourceID] = sn
+			topics[sourceID] = map[string]*IndicatorTopicNode{}
+			tree.Sources = append(tree.Sources, sn)
+		}
+
+		indicatorTopics := []IDAndValue{}
+		for _, t := range ind.Topics {
+			if t != nil && t.ID != "" {
+				indicatorTopics = append(indicatorTopics, IDAndValue{ID: t.ID, Value: strings.TrimSpace(t.Value)})
+			}
+		}
+		if len(indicatorTopics) == 0 {
+			indicatorTopics = append(indicatorTopics, IDAndValue{})
+		}
+
+		for _, t := range indicatorTopics {
+			tn, ok := topics[sourceID][t.ID]
+			if !ok {
+				tn = &IndicatorTopicNode{Topic: t}
+				topics[sourceID][t.ID] = tn
+				sn.Topics = append(sn.Topics, tn)
+			}
+			tn.Indicators = append(tn.Indicators, ind)
+		}
+	}
+
+	sort.SliceStable(tree.Sources, func(i, j int) bool {
+		return lessID(tree.Sources[i].Source.ID, tree.Sources[j].Source.ID)
+	})
+	for _, sn := range tree.Sources {
+		sn := sn
+		sort.SliceStable(sn.Topics, func(i, j int) bool {
+			return lessID(sn.Topics[i].Topic.ID, sn.Topics[j].Topic.ID)
+		})
+	}
+
+	return tree
+}
+
+// Source returns the node of the source. It returns nil if the tree has no such source.
+func (t *IndicatorTree) Source(sourceID string) *IndicatorSourceNode {
+	for _, sn := range t.Sources {
+		if sn.Source.ID == sourceID {
+			return sn
+		}
+	}
+
+	return nil
+}
+
+// Topic returns the node of the topic. It returns nil if the source has no such topic.
+func (sn *IndicatorSourceNode) Topic(topicID string) *IndicatorTopicNode {
+	for _, tn := range sn.Topics {
+		if tn.Topic.ID == topicID {
+			return tn
+		}
+	}
+
+	return nil
+}
+
+// lessID compares IDs numerically if both are numbers like source and topic IDs, otherwise lexically
+func lessID(a, b string) bool {
+	if len(a) != len(b) && isDigits(a) && isDigits(b) {
+		return len(a) < len(b)
+	}
+
+	return a < b
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
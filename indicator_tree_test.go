@@ -0,0 +1,62 @@
+package wbdata
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewIndicatorTree(t *testing.T) {
+	wdi := &IDAndValue{ID: "2", Value: "World Development Indicators"}
+	adi := &IDAndValue{ID: "11", Value: "Africa Development Indicators"}
+	tractors := &Indicator{
+		ID:     "AG.AGR.TRAC.NO",
+		Source: adi,
+		Topics: []*IDAndValue{{ID: "1", Value: "Agriculture & Rural Development  "}},
+	}
+	electricity := &Indicator{
+		ID:     "EG.ELC.ACCS.ZS",
+		Source: adi,
+		Topics: []*IDAndValue{{ID: "19", Value: "Climate Change"}, {ID: "6", Value: "Environment "}},
+	}
+	business := &Indicator{
+		ID:     "IC.BUS.EASE.XQ",
+		Source: adi,
+		Topics: []*IDAndValue{{}},
+	}
+	gdp := &Indicator{
+		ID:     "NY.GDP.MKTP.CD",
+		Source: wdi,
+		Topics: []*IDAndValue{{ID: "3", Value: "Economy & Growth"}},
+	}
+
+	got := NewIndicatorTree([]*Indicator{tractors, electricity, business, gdp})
+	want := &IndicatorTree{
+		Sources: []*IndicatorSourceNode{
+			{
+				Source: *wdi,
+				Topics: []*IndicatorTopicNode{
+					{Topic: IDAndValue{ID: "3", Value: "Economy & Growth"}, Indicators: []*Indicator{gdp}},
+				},
+			},
+			{
+				Source: *adi,
+				Topics: []*IndicatorTopicNode{
+					{Topic: IDAndValue{}, Indicators: []*Indicator{business}},
+					{Topic: IDAndValue{ID: "1", Value: "Agriculture & Rural Development"}, Indicators: []*Indicator{tractors}},
+					{Topic: IDAndValue{ID: "6", Value: "Environment"}, Indicators: []*Indicator{electricity}},
+					{Topic: IDAndValue{ID: "19", Value: "Climate Change"}, Indicators: []*Indicator{electricity}},
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NewIndicatorTree() = %+v, want %+v", got, want)
+	}
+
+	if tn := got.Source("11").Topic("6"); tn == nil || tn.Indicators[0] != electricity {
+		t.Errorf("IndicatorTree.Source().Topic() = %+v, want the node of topic 6", tn)
+	}
+	if sn := got.Source("invalid_source_id"); sn != nil {
+		t.Errorf("IndicatorTree.Source() = %+v, want nil", sn)
+	}
+}
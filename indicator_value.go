@@ -1,6 +1,7 @@
 package wbdata
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 )
@@ -19,6 +20,8 @@ type (
 		Unit            string     `json:"unit"`
 		ObsStatus       string     `json:"obs_status"`
 		Decimal         int32      `json:"decimal"`
+		// Null is true when the API returns null as the value. Value is 0 then.
+		Null bool `json:"-"`
 	}
 
 	// IndicatorValueWithFootnote represents an indicator value with footnote
@@ -26,8 +29,150 @@ type (
 		IndicatorValue
 		Footnote string `json:"footnote"`
 	}
+
+	// AggregateIDs is a set of the IDs and ISO2 codes of aggregates.
+	// IndicatorValue has the ISO3 code and the ISO2 code as the country ID, so both are needed.
+	AggregateIDs map[string]bool
 )
 
+// IsNull reports whether the value is null
+func (iv *IndicatorValue) IsNull() bool {
+	return iv.Null
+}
+
+// countryCode returns the ISO3 code of the country, or the country ID if it is empty
+func (iv *IndicatorValue) countryCode() string {
+	if iv.Countryiso3code != "" {
+		return iv.Countryiso3code
+	}
+
+	return iv.Country.ID
+}
+
+// IsAggregate reports whether the value is of an aggregate in aggregates
+func (iv *IndicatorValue) IsAggregate(aggregates AggregateIDs) bool {
+	return aggregates[iv.Countryiso3code] || aggregates[iv.Country.ID]
+}
+
+// NewAggregateIDs returns the AggregateIDs of the aggregates in countries, e.g. the result of CountriesService.List
+func NewAggregateIDs(countries []*Country) AggregateIDs {
+	aggregates := AggregateIDs{}
+	for _, c := range countries {
+		if !c.IsAggregate() {
+			continue
+		}
+		aggregates[c.ID] = true
+		if c.Iso2Code != "" {
+			aggregates[c.Iso2Code] = true
+		}
+	}
+
+	return aggregates
+}
+
+// ExcludeAggregates returns the values which are not of aggregates
+func ExcludeAggregates(indicatorValues []*IndicatorValue, aggregates AggregateIDs) []*IndicatorValue {
+	filtered := []*IndicatorValue{}
+	for _, iv := range indicatorValues {
+		if !iv.IsAggregate(aggregates) {
+			filtered = append(filtered, iv)
+		}
+	}
+
+	return filtered
+}
+
+// OnlyAggregates returns the values of aggregates
+func OnlyAggregates(indicatorValues []*IndicatorValue, aggregates AggregateIDs) []*IndicatorValue {
+	filtered := []*IndicatorValue{}
+	for _, iv := range indicatorValues {
+		if iv.IsAggregate(aggregates) {
+			filtered = append(filtered, iv)
+		}
+	}
+
+	return filtered
+}
+
+// UnmarshalJSON decodes an indicator value and keeps whether the value is null
+func (iv *IndicatorValue) UnmarshalJSON(data []byte) error {
+	type indicatorValue IndicatorValue
+	aux := struct {
+		*indicatorValue
+		Value *float64 `json:"value"`
+	}{
+		indicatorValue: (*indicatorValue)(iv),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	if aux.Value == nil {
+		iv.Value = 0
+		iv.Null = true
+	} else {
+		iv.Value = *aux.Value
+		iv.Null = false
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes an indicator value, with null as the value if it is null
+func (iv IndicatorValue) MarshalJSON() ([]byte, error) {
+	type indicatorValue IndicatorValue
+
+	return json.Marshal(struct {
+		indicatorValue
+		Value *float64 `json:"value"`
+	}{
+		indicatorValue: indicatorValue(iv),
+		Value:          iv.nullableValue(),
+	})
+}
+
+// nullableValue returns the value, or nil if it is null
+func (iv *IndicatorValue) nullableValue() *float64 {
+	if iv.Null {
+		return nil
+	}
+	v := iv.Value
+
+	return &v
+}
+
+// MarshalJSON encodes an indicator value with footnote
+func (iv IndicatorValueWithFootnote) MarshalJSON() ([]byte, error) {
+	type indicatorValue IndicatorValue
+
+	return json.Marshal(struct {
+		indicatorValue
+		Value    *float64 `json:"value"`
+		Footnote string   `json:"footnote"`
+	}{
+		indicatorValue: indicatorValue(iv.IndicatorValue),
+		Value:          iv.nullableValue(),
+		Footnote:       iv.Footnote,
+	})
+}
+
+// UnmarshalJSON decodes an indicator value with footnote
+func (iv *IndicatorValueWithFootnote) UnmarshalJSON(data []byte) error {
+	if err := iv.IndicatorValue.UnmarshalJSON(data); err != nil {
+		return err
+	}
+
+	aux := struct {
+		Footnote string `json:"footnote"`
+	}{}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	iv.Footnote = aux.Footnote
+
+	return nil
+}
+
 // List returns a Response's Summary and Indicator in all countries
 func (i *IndicatorValuesService) List(
 	indicatorID string,
@@ -323,3 +468,34 @@ func (i *IndicatorValuesService) ListByCountryIDsAndSourceIDWithFootnote(
 
 	return summary, indicatorValues, nil
 }
+
+// listAll returns the values of the indicator in all pages.
+// If countryIDs are empty, the values in all countries are returned.
+func (i *IndicatorValuesService) listAll(
+	countryIDs []string,
+	indicatorID string,
+	filterParams *FilterParams,
+	perPage int,
+) ([]*IndicatorValue, error) {
+	indicatorValues := []*IndicatorValue{}
+	err := forEachPage(perPage, func(pages *PageParams) (*PageSummary, error) {
+		var summary *PageSummaryWithSourceID
+		var ivs []*IndicatorValue
+		var err error
+		if len(countryIDs) == 0 {
+			summary, ivs, err = i.List(indicatorID, filterParams, pages)
+		} else {
+			summary, ivs, err = i.ListByCountryIDs(countryIDs, indicatorID, filterParams, pages)
+		}
+		if err != nil {
+			return nil, err
+		}
+		indicatorValues = append(indicatorValues, ivs...)
+		return &PageSummary{Page: summary.Page, Pages: summary.Pages, PerPage: summary.PerPage, Total: summary.Total}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return indicatorValues, nil
+}
@@ -1,6 +1,7 @@
 package wbdata
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"strings"
@@ -1900,3 +1901,176 @@ func TestIndicatorValuesService_ListByCountryIDsAndSourceIDWithFootnote(t *testi
 		})
 	}
 }
+
+func TestIndicatorValueWithFootnote_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		want     *IndicatorValueWithFootnote
+		wantNull bool
+		wantErr  bool
+	}{
+		{
+			name: "success",
+			data: `{"indicator":{"id":"NY.GDP.MKTP.CD","value":"GDP (current US$)"},"date":"2019","value":5081769542379.77,"footnote":"note"}`,
+			want: &IndicatorValueWithFootnote{
+				IndicatorValue: IndicatorValue{
+					Indicator: IDAndValue{
+						ID:    "NY.GDP.MKTP.CD",
+						Value: "GDP (current US$)",
+					},
+					Date:  "2019",
+					Value: 5.08176954237977e+12,
+				},
+				Footnote: "note",
+			},
+			wantNull: false,
+			wantErr:  false,
+		},
+		{
+			name: "success with null value",
+			data: `{"indicator":{"id":"NY.GDP.MKTP.CD","value":"GDP (current US$)"},"date":"2020","value":null,"footnote":""}`,
+			want: &IndicatorValueWithFootnote{
+				IndicatorValue: IndicatorValue{
+					Indicator: IDAndValue{
+						ID:    "NY.GDP.MKTP.CD",
+						Value: "GDP (current US$)",
+					},
+					Date: "2020",
+					Null: true,
+				},
+			},
+			wantNull: true,
+			wantErr:  false,
+		},
+		{
+			name:    "failure because invalid value",
+			data:    `{"value":"invalid"}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := &IndicatorValueWithFootnote{}
+			err := json.Unmarshal([]byte(tt.data), got)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IndicatorValueWithFootnote.UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.want != nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("IndicatorValueWithFootnote.UnmarshalJSON() got = %v, want %v", got, tt.want)
+			}
+			if tt.want != nil && got.IsNull() != tt.wantNull {
+				t.Errorf("IndicatorValueWithFootnote.IsNull() = %v, want %v", got.IsNull(), tt.wantNull)
+			}
+		})
+	}
+}
+
+func TestIndicatorValue_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		iv   *IndicatorValueWithFootnote
+		want string
+	}{
+		{
+			name: "success",
+			iv: &IndicatorValueWithFootnote{
+				IndicatorValue: IndicatorValue{
+					Indicator: IDAndValue{ID: "NY.GDP.MKTP.CD", Value: "GDP (current US$)"},
+					Date:      "2019",
+					Value:     0,
+				},
+				Footnote: "note",
+			},
+			want: `"value":0`,
+		},
+		{
+			name: "success with null value",
+			iv: &IndicatorValueWithFootnote{
+				IndicatorValue: IndicatorValue{
+					Indicator: IDAndValue{ID: "NY.GDP.MKTP.CD", Value: "GDP (current US$)"},
+					Date:      "2020",
+					Null:      true,
+				},
+			},
+			want: `"value":null`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, v := range []interface{}{tt.iv, &tt.iv.IndicatorValue} {
+				data, err := json.Marshal(v)
+				if err != nil {
+					t.Fatalf("json.Marshal() error = %v", err)
+				}
+				if !strings.Contains(string(data), tt.want) || strings.Contains(string(data), "Null") {
+					t.Errorf("json.Marshal() = %s, want %s", data, tt.want)
+				}
+			}
+
+			data, err := json.Marshal(tt.iv)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			got := &IndicatorValueWithFootnote{}
+			if err := json.Unmarshal(data, got); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.iv) {
+				t.Errorf("json.Unmarshal(json.Marshal()) = %+v, want %+v", got, tt.iv)
+			}
+		})
+	}
+}
+
+func TestExcludeAggregates(t *testing.T) {
+	aggregates := NewAggregateIDs([]*Country{
+		{ID: "JPN", Iso2Code: "JP", Region: CountryRegion{ID: "EAS"}},
+		{ID: "WLD", Iso2Code: "1W", Region: CountryRegion{ID: "NA"}},
+		{ID: "HIC", Iso2Code: "XD", Region: CountryRegion{ID: "NA"}},
+	})
+	japan := &IndicatorValue{Country: IDAndValue{ID: "JP"}, Countryiso3code: "JPN"}
+	world := &IndicatorValue{Country: IDAndValue{ID: "1W"}, Countryiso3code: "WLD"}
+	// NOTE: some aggregates have no ISO3 code in the API response
+	highIncome := &IndicatorValue{Country: IDAndValue{ID: "XD"}}
+	values := []*IndicatorValue{japan, world, highIncome}
+
+	if got, want := ExcludeAggregates(values, aggregates), []*IndicatorValue{japan}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ExcludeAggregates() = %v, want %v", got, want)
+	}
+	if got, want := OnlyAggregates(values, aggregates), []*IndicatorValue{world, highIncome}; !reflect.DeepEqual(got, want) {
+		t.Errorf("OnlyAggregates() = %v, want %v", got, want)
+	}
+}
+
+func newTestIndicatorValues() []*IndicatorValue {
+	return []*IndicatorValue{
+		{
+			Indicator: IDAndValue{
+				ID:    "NY.GDP.MKTP.CD",
+				Value: "GDP (current US$)",
+			},
+			Country: IDAndValue{
+				ID:    "JP",
+				Value: "Japan",
+			},
+			Countryiso3code: "JPN",
+			Date:            "2020",
+			Null:            true,
+		},
+		{
+			Indicator: IDAndValue{
+				ID:    "NY.GDP.MKTP.CD",
+				Value: "GDP (current US$)",
+			},
+			Country: IDAndValue{
+				ID:    "JP",
+				Value: "Japan",
+			},
+			Countryiso3code: "JPN",
+			Date:            "2019",
+			Value:           5.08176954237977e+12,
+		},
+	}
+}
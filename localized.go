@@ -0,0 +1,293 @@
+package wbdata
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	// CatalogCountries is the catalog of countries
+	CatalogCountries CatalogType = "countries"
+	// CatalogIndicators is the catalog of indicators
+	CatalogIndicators CatalogType = "indicators"
+	// CatalogTopics is the catalog of topics
+	CatalogTopics CatalogType = "topics"
+	// CatalogRegions is the catalog of regions
+	CatalogRegions CatalogType = "regions"
+	// CatalogSources is the catalog of sources
+	CatalogSources CatalogType = "sources"
+
+	defaultCatalogBaseLanguage = "en"
+	defaultCatalogPerPage      = 1000
+)
+
+type (
+	// CatalogType is the type of a catalog
+	CatalogType string
+
+	// LocalizedString is a text keyed by language codes
+	LocalizedString map[string]string
+
+	// LocalizedCatalogParams contains parameters for LanguagesService.LocalizedCatalog
+	LocalizedCatalogParams struct {
+		// Languages are the language codes to fetch. If it is empty, all languages of List are fetched.
+		Languages []string
+		// BaseLanguage is the language which the API falls back to silently. Defaults to en.
+		BaseLanguage string
+		// Fallback is the fallback chain of languages for a missing text. Defaults to BaseLanguage.
+		Fallback []string
+		// PerPage is the number of items requested at a time. Defaults to 1000.
+		PerPage int
+	}
+
+	// LocalizedCatalog is a catalog merged across languages
+	LocalizedCatalog struct {
+		Catalog      CatalogType
+		Languages    []string
+		BaseLanguage string
+		Fallback     []string
+		Entries      []*LocalizedEntry
+
+		entries map[string]*LocalizedEntry
+	}
+
+	// LocalizedEntry is an item of a catalog, e.g. a country, with the texts of the fields in every language.
+	// Fields are keyed by the field names, e.g. Name of Country and SourceNote of Indicator.
+	LocalizedEntry struct {
+		ID     string
+		Fields map[string]LocalizedString
+	}
+
+	// UntranslatedEntry is a text which is not translated into a language
+	UntranslatedEntry struct {
+		ID       string
+		Field    string
+		Language string
+		// Missing is true if the text is empty. Otherwise the text is the same as the one in the base language.
+		Missing bool
+	}
+
+	catalogField struct {
+		id   string
+		name string
+		text string
+	}
+)
+
+func (ct CatalogType) String() string {
+	return string(ct)
+}
+
+// Lookup returns the text in language, or the first text in the fallback chain, and the language of the text.
+// It returns empty strings if no text is found.
+func (ls LocalizedString) Lookup(language string, fallback []string) (string, string) {
+	for _, lang := range append([]string{language}, fallback...) {
+		if text := ls[lang]; text != "" {
+			return text, lang
+		}
+	}
+
+	return "", ""
+}
+
+// LocalizedCatalog fetches the catalog in each language and merges the results
+func (l *LanguagesService) LocalizedCatalog(catalog CatalogType, params *LocalizedCatalogParams) (*LocalizedCatalog, error) {
+	if params == nil {
+		params = &LocalizedCatalogParams{}
+	}
+	baseLanguage := params.BaseLanguage
+	if baseLanguage == "" {
+		baseLanguage = defaultCatalogBaseLanguage
+	}
+	fallback := params.Fallback
+	if len(fallback) == 0 {
+		fallback = []string{baseLanguage}
+	}
+	perPage := params.PerPage
+	if perPage == 0 {
+		perPage = defaultCatalogPerPage
+	}
+
+	languages := params.Languages
+	if len(languages) == 0 {
+		all := []*Language{}
+		err := forEachPage(perPage, func(pages *PageParams) (*PageSummary, error) {
+			summary, ls, err := l.List(pages)
+			all = append(all, ls...)
+			return summary, err
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, lang := range all {
+			languages = append(languages, lang.Code)
+		}
+	}
+
+	lc := &LocalizedCatalog{
+		Catalog:      catalog,
+		Languages:    languages,
+		BaseLanguage: baseLanguage,
+		Fallback:     fallback,
+		entries:      map[string]*LocalizedEntry{},
+	}
+	for _, lang := range languages {
+		fields, err := fetchCatalogFields(l.client.withLanguage(lang), catalog, perPage)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch %s in %s: %v", catalog, lang, err)
+		}
+		for _, f := range fields {
+			lc.set(f.id, f.name, lang, f.text)
+		}
+	}
+
+	return lc, nil
+}
+
+// Entry returns the entry of id. It returns nil if the catalog has no such entry.
+func (lc *LocalizedCatalog) Entry(id string) *LocalizedEntry {
+	if lc.entries == nil {
+		lc.buildIndex()
+	}
+
+	return lc.entries[id]
+}
+
+// Text returns the text of the field of the entry in language, using the fallback chain of the catalog
+func (lc *LocalizedCatalog) Text(id, field, language string) string {
+	entry := lc.Entry(id)
+	if entry == nil {
+		return ""
+	}
+	text, _ := entry.Fields[field].Lookup(language, lc.Fallback)
+
+	return text
+}
+
+// Untranslated returns the texts which are missing or the same as the ones in the base language,
+// ordered by ID, field and language.
+// Names which are the same in several languages, e.g. Canada, are reported as well.
+func (lc *LocalizedCatalog) Untranslated() []*UntranslatedEntry {
+	untranslated := []*UntranslatedEntry{}
+	for _, entry := range lc.Entries {
+		fields := make([]string, 0, len(entry.Fields))
+		for name := range entry.Fields {
+			fields = append(fields, name)
+		}
+		sort.Strings(fields)
+
+		for _, name := range fields {
+			texts := entry.Fields[name]
+			base := texts[lc.BaseLanguage]
+			if base == "" {
+				continue
+			}
+			for _, lang := range lc.Languages {
+				if lang == lc.BaseLanguage {
+					continue
+				}
+				text := texts[lang]
+				if text == "" || text == base {
+					untranslated = append(untranslated, &UntranslatedEntry{
+						ID:       entry.ID,
+						Field:    name,
+						Language: lang,
+						Missing:  text == "",
+					})
+				}
+			}
+		}
+	}
+
+	return untranslated
+}
+
+func (lc *LocalizedCatalog) set(id, field, language, text string) {
+	entry, ok := lc.entries[id]
+	if !ok {
+		entry = &LocalizedEntry{ID: id, Fields: map[string]LocalizedString{}}
+		lc.entries[id] = entry
+		lc.Entries = append(lc.Entries, entry)
+	}
+	if entry.Fields[field] == nil {
+		entry.Fields[field] = LocalizedString{}
+	}
+	entry.Fields[field][language] = strings.TrimSpace(text)
+}
+
+func (lc *LocalizedCatalog) buildIndex() {
+	lc.entries = map[string]*LocalizedEntry{}
+	for _, entry := range lc.Entries {
+		lc.entries[entry.ID] = entry
+	}
+}
+
+// fetchCatalogFields fetches all items of the catalog and returns the texts of their fields
+func fetchCatalogFields(c *Client, catalog CatalogType, perPage int) ([]*catalogField, error) {
+	fields := []*catalogField{}
+	add := func(id string, texts ...string) {
+		for i := 0; i+1 < len(texts); i += 2 {
+			fields = append(fields, &catalogField{id: id, name: texts[i], text: texts[i+1]})
+		}
+	}
+
+	var list func(pages *PageParams) (*PageSummary, error)
+	switch catalog {
+	case CatalogCountries:
+		list = func(pages *PageParams) (*PageSummary, error) {
+			summary, countries, err := c.Countries.List(nil, pages)
+			for _, v := range countries {
+				add(v.ID,
+					"Name", v.Name,
+					"CapitalCity", v.CapitalCity,
+					"Region", v.Region.Value,
+					"AdminRegion", v.AdminRegion.Value,
+					"IncomeLevel", v.IncomeLevel.Value,
+					"LendingType", v.LendingType.Value,
+				)
+			}
+			return summary, err
+		}
+	case CatalogIndicators:
+		list = func(pages *PageParams) (*PageSummary, error) {
+			summary, indicators, err := c.Indicators.List(pages)
+			for _, v := range indicators {
+				add(v.ID, "Name", v.Name, "SourceNote", v.SourceNote, "SourceOrganization", v.SourceOrganization)
+			}
+			return summary, err
+		}
+	case CatalogTopics:
+		list = func(pages *PageParams) (*PageSummary, error) {
+			summary, topics, err := c.Topics.List(pages)
+			for _, v := range topics {
+				add(v.ID, "Value", v.Value, "SourceNote", v.SourceNote)
+			}
+			return summary, err
+		}
+	case CatalogRegions:
+		list = func(pages *PageParams) (*PageSummary, error) {
+			summary, regions, err := c.Regions.List(pages)
+			for _, v := range regions {
+				add(v.Code, "Name", v.Name)
+			}
+			return summary, err
+		}
+	case CatalogSources:
+		list = func(pages *PageParams) (*PageSummary, error) {
+			summary, sources, err := c.Sources.List(pages)
+			for _, v := range sources {
+				add(v.ID, "Name", v.Name, "Description", v.Description)
+			}
+			return summary, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported catalog: %s", catalog)
+	}
+
+	if err := forEachPage(perPage, list); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
@@ -0,0 +1,101 @@
+package wbdata
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLocalizedString_Lookup(t *testing.T) {
+	ls := LocalizedString{
+		"en": "Japan",
+		"ja": "日本",
+		"fr": "",
+	}
+
+	tests := []struct {
+		name     string
+		language string
+		fallback []string
+		want     string
+		want1    string
+	}{
+		{
+			name:     "success",
+			language: "ja",
+			fallback: []string{"en"},
+			want:     "日本",
+			want1:    "ja",
+		},
+		{
+			name:     "fallback because empty",
+			language: "fr",
+			fallback: []string{"ar", "en"},
+			want:     "Japan",
+			want1:    "en",
+		},
+		{
+			name:     "not found",
+			language: "ar",
+			fallback: nil,
+			want:     "",
+			want1:    "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1 := ls.Lookup(tt.language, tt.fallback)
+			if got != tt.want || got1 != tt.want1 {
+				t.Errorf("LocalizedString.Lookup() = %v, %v, want %v, %v", got, got1, tt.want, tt.want1)
+			}
+		})
+	}
+}
+
+func TestLanguagesService_LocalizedCatalog(t *testing.T) {
+	client, save := NewTestClient(t, *update)
+	defer save()
+
+	l := &LanguagesService{
+		client: client,
+	}
+	got, err := l.LocalizedCatalog(CatalogTopics, &LocalizedCatalogParams{PerPage: 50})
+	if err != nil {
+		t.Fatalf("LanguagesService.LocalizedCatalog() error = %v", err)
+	}
+
+	if want := []string{"en", "ja"}; !reflect.DeepEqual(got.Languages, want) {
+		t.Errorf("LanguagesService.LocalizedCatalog() Languages = %v, want %v", got.Languages, want)
+	}
+	wantEntry := &LocalizedEntry{
+		ID: "1",
+		Fields: map[string]LocalizedString{
+			"Value": {"en": "Agriculture & Rural Development", "ja": "農業・農村開発"},
+			"SourceNote": {
+				"en": "For the 70 percent of the world's poor who live in rural areas, agriculture is the main source of income and employment.",
+				"ja": "For the 70 percent of the world's poor who live in rural areas, agriculture is the main source of income and employment.",
+			},
+		},
+	}
+	if entry := got.Entry("1"); !reflect.DeepEqual(entry, wantEntry) {
+		t.Errorf("LocalizedCatalog.Entry() = %+v, want %+v", entry, wantEntry)
+	}
+
+	if text := got.Text("2", "Value", "ja"); text != "Aid Effectiveness" {
+		t.Errorf("LocalizedCatalog.Text() = %v, want %v", text, "Aid Effectiveness")
+	}
+	if text := got.Text("invalid_topic_id", "Value", "ja"); text != "" {
+		t.Errorf("LocalizedCatalog.Text() = %v, want empty", text)
+	}
+
+	wantUntranslated := []*UntranslatedEntry{
+		{ID: "1", Field: "SourceNote", Language: "ja", Missing: false},
+		{ID: "2", Field: "Value", Language: "ja", Missing: true},
+	}
+	if untranslated := got.Untranslated(); !reflect.DeepEqual(untranslated, wantUntranslated) {
+		t.Errorf("LocalizedCatalog.Untranslated() = %+v, want %+v", untranslated, wantUntranslated)
+	}
+
+	if _, err := l.LocalizedCatalog("invalid_catalog", &LocalizedCatalogParams{Languages: []string{"en"}}); err == nil {
+		t.Errorf("LanguagesService.LocalizedCatalog() error = nil, want error")
+	}
+}
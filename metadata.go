@@ -0,0 +1,393 @@
+package wbdata
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	metadataIDSeparator = "~"
+	metadataYearPrefix  = "YR"
+)
+
+var (
+	metadataYearRegex = regexp.MustCompile(`^\d{4}$`)
+	metadataKeyRegex  = regexp.MustCompile(`[^a-z0-9]`)
+)
+
+type (
+	// MetadataService ...
+	MetadataService service
+
+	// MetadataEntry represents a metatype of the metadata API
+	MetadataEntry struct {
+		ID    string `json:"id"`
+		Value string `json:"value"`
+	}
+
+	// SeriesMetadata contains metadata of a series (indicator)
+	SeriesMetadata struct {
+		ID                               string
+		IndicatorName                    string
+		Topic                            string
+		Source                           string
+		ShortDefinition                  string
+		LongDefinition                   string
+		UnitOfMeasure                    string
+		Periodicity                      string
+		BasePeriod                       string
+		ReferencePeriod                  string
+		AggregationMethod                string
+		LimitationsAndExceptions         string
+		NotesFromOriginalSource          string
+		GeneralComments                  string
+		StatisticalConceptAndMethodology string
+		DevelopmentRelevance             string
+		RelatedSourceLinks               string
+		OtherWebLinks                    string
+		RelatedIndicators                string
+		LicenseType                      string
+		LicenseURL                       string
+		// Others contains metatypes which are not recognized, keyed by the metatype IDs
+		Others map[string]string
+	}
+
+	// CountryMetadata contains metadata of a country
+	CountryMetadata struct {
+		ID                                         string
+		ShortName                                  string
+		TableName                                  string
+		LongName                                   string
+		Alpha2Code                                 string
+		WB2Code                                    string
+		CurrencyUnit                               string
+		SpecialNotes                               string
+		Region                                     string
+		IncomeGroup                                string
+		LendingCategory                            string
+		OtherGroups                                string
+		SystemOfNationalAccounts                   string
+		NationalAccountsBaseYear                   string
+		NationalAccountsReferenceYear              string
+		SNAPriceValuation                          string
+		AlternativeConversionFactor                string
+		PPPSurveyYear                              string
+		BalanceOfPaymentsManualInUse               string
+		ExternalDebtReportingStatus                string
+		SystemOfTrade                              string
+		GovernmentAccountingConcept                string
+		IMFDataDisseminationStandard               string
+		LatestPopulationCensus                     string
+		LatestHouseholdSurvey                      string
+		SourceOfMostRecentIncomeAndExpenditureData string
+		VitalRegistrationComplete                  string
+		LatestAgriculturalCensus                   string
+		LatestIndustrialData                       string
+		LatestTradeData                            string
+		// Others contains metatypes which are not recognized, keyed by the metatype IDs
+		Others map[string]string
+	}
+
+	// FootnoteMetadata contains a footnote of a value
+	FootnoteMetadata struct {
+		FootnoteKey
+		Footnote string
+		// Others contains metatypes which are not recognized, keyed by the metatype IDs
+		Others map[string]string
+	}
+
+	// FootnoteKey identifies a value with a footnote
+	FootnoteKey struct {
+		CountryID   string
+		IndicatorID string
+		// Date is the date of the value, e.g. 2019 or YR2019
+		Date string
+	}
+
+	metadataResponse struct {
+		PageSummaryWithSourceID
+		Source metadataSources `json:"source"`
+	}
+
+	metadataSource struct {
+		ID       string             `json:"id"`
+		Name     string             `json:"name"`
+		Concepts []*metadataConcept `json:"concept"`
+	}
+
+	metadataSources []*metadataSource
+
+	metadataConcept struct {
+		ID        string              `json:"id"`
+		Variables []*metadataVariable `json:"variable"`
+	}
+
+	metadataVariable struct {
+		ID        string           `json:"id"`
+		MetaTypes []*MetadataEntry `json:"metatype"`
+	}
+)
+
+// ListSeries returns a Response's Summary and SeriesMetadata of a source.
+// If seriesIDs are empty, metadata of all series are returned.
+func (m *MetadataService) ListSeries(
+	sourceID string,
+	seriesIDs []string,
+	pages *PageParams,
+) (*PageSummaryWithSourceID, []*SeriesMetadata, error) {
+	summary, variables, err := m.list(sourceID, ConceptSeries, seriesIDs, pages)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metadata := []*SeriesMetadata{}
+	for _, v := range variables {
+		sm := &SeriesMetadata{ID: v.ID}
+		sm.Others = setMetadataFields(sm.fields(), v.MetaTypes)
+		metadata = append(metadata, sm)
+	}
+
+	return summary, metadata, nil
+}
+
+// GetSeries returns a Response's Summary and SeriesMetadata of a series
+func (m *MetadataService) GetSeries(sourceID, seriesID string) (*PageSummaryWithSourceID, *SeriesMetadata, error) {
+	summary, metadata, err := m.ListSeries(sourceID, []string{seriesID}, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(metadata) == 0 {
+		return nil, nil, fmt.Errorf("metadata of series %s is not found", seriesID)
+	}
+
+	return summary, metadata[0], nil
+}
+
+// ListCountries returns a Response's Summary and CountryMetadata of a source.
+// If countryIDs are empty, metadata of all countries are returned.
+func (m *MetadataService) ListCountries(
+	sourceID string,
+	countryIDs []string,
+	pages *PageParams,
+) (*PageSummaryWithSourceID, []*CountryMetadata, error) {
+	summary, variables, err := m.list(sourceID, ConceptCountry, countryIDs, pages)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metadata := []*CountryMetadata{}
+	for _, v := range variables {
+		cm := &CountryMetadata{ID: v.ID}
+		cm.Others = setMetadataFields(cm.fields(), v.MetaTypes)
+		metadata = append(metadata, cm)
+	}
+
+	return summary, metadata, nil
+}
+
+// GetCountry returns a Response's Summary and CountryMetadata of a country
+func (m *MetadataService) GetCountry(sourceID, countryID string) (*PageSummaryWithSourceID, *CountryMetadata, error) {
+	summary, metadata, err := m.ListCountries(sourceID, []string{countryID}, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(metadata) == 0 {
+		return nil, nil, fmt.Errorf("metadata of country %s is not found", countryID)
+	}
+
+	return summary, metadata[0], nil
+}
+
+// ListFootnotes returns a Response's Summary and FootnoteMetadata of values
+func (m *MetadataService) ListFootnotes(
+	sourceID string,
+	keys []*FootnoteKey,
+	pages *PageParams,
+) (*PageSummaryWithSourceID, []*FootnoteMetadata, error) {
+	if len(keys) == 0 {
+		return nil, nil, errors.New("footnote keys are required")
+	}
+
+	ids := make([]string, 0, len(keys))
+	for _, k := range keys {
+		id, err := k.id()
+		if err != nil {
+			return nil, nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	summary, variables, err := m.list(sourceID, "footnote", ids, pages)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metadata := []*FootnoteMetadata{}
+	for _, v := range variables {
+		fm := &FootnoteMetadata{FootnoteKey: parseFootnoteKey(v.ID)}
+		fm.Others = setMetadataFields(map[string]*string{"footnote": &fm.Footnote}, v.MetaTypes)
+		metadata = append(metadata, fm)
+	}
+
+	return summary, metadata, nil
+}
+
+func (m *MetadataService) list(
+	sourceID string,
+	concept string,
+	ids []string,
+	pages *PageParams,
+) (*PageSummaryWithSourceID, []*metadataVariable, error) {
+	if sourceID == "" {
+		return nil, nil, errors.New("source id is required")
+	}
+
+	selector := dimensionSelectorAll
+	if len(ids) != 0 {
+		selector = strings.Join(ids, ";")
+	}
+
+	path := fmt.Sprintf("sources/%s/%s/%s/metadata", sourceID, concept, selector)
+	req, err := m.client.NewRequest("GET", path, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := pages.addPageParams(req); err != nil {
+		return nil, nil, err
+	}
+
+	res := &metadataResponse{}
+	if err = m.client.do(req, res); err != nil {
+		return nil, nil, err
+	}
+
+	summary := &res.PageSummaryWithSourceID
+	variables := []*metadataVariable{}
+	for _, source := range res.Source {
+		summary.SourceID = source.ID
+		for _, c := range source.Concepts {
+			variables = append(variables, c.Variables...)
+		}
+	}
+
+	return summary, variables, nil
+}
+
+// id returns the variable ID of the footnote, e.g. JPN~NY.GDP.MKTP.CD~YR2019
+func (k *FootnoteKey) id() (string, error) {
+	if k == nil || k.CountryID == "" || k.IndicatorID == "" || k.Date == "" {
+		return "", fmt.Errorf("country id, indicator id and date are required. footnote key: %v", k)
+	}
+
+	date := k.Date
+	if metadataYearRegex.MatchString(date) {
+		date = metadataYearPrefix + date
+	}
+
+	return strings.Join([]string{k.CountryID, k.IndicatorID, date}, metadataIDSeparator), nil
+}
+
+func parseFootnoteKey(id string) FootnoteKey {
+	elems := strings.SplitN(id, metadataIDSeparator, 3)
+	if len(elems) != 3 {
+		return FootnoteKey{CountryID: id}
+	}
+
+	return FootnoteKey{
+		CountryID:   elems[0],
+		IndicatorID: elems[1],
+		Date:        strings.TrimPrefix(elems[2], metadataYearPrefix),
+	}
+}
+
+// setMetadataFields sets the values of metatypes to fields by normalized keys
+// and returns the other metatypes
+func setMetadataFields(fields map[string]*string, entries []*MetadataEntry) map[string]string {
+	var others map[string]string
+	for _, e := range entries {
+		if field, ok := fields[metadataKey(e.ID)]; ok {
+			*field = e.Value
+			continue
+		}
+		if others == nil {
+			others = map[string]string{}
+		}
+		others[e.ID] = e.Value
+	}
+
+	return others
+}
+
+// metadataKey normalizes a metatype ID because the API returns IDs like Longdefinition and "Long definition"
+func metadataKey(id string) string {
+	return metadataKeyRegex.ReplaceAllString(strings.ToLower(id), "")
+}
+
+func (sm *SeriesMetadata) fields() map[string]*string {
+	return map[string]*string{
+		"indicatorname":                    &sm.IndicatorName,
+		"topic":                            &sm.Topic,
+		"source":                           &sm.Source,
+		"shortdefinition":                  &sm.ShortDefinition,
+		"longdefinition":                   &sm.LongDefinition,
+		"unitofmeasure":                    &sm.UnitOfMeasure,
+		"periodicity":                      &sm.Periodicity,
+		"baseperiod":                       &sm.BasePeriod,
+		"referenceperiod":                  &sm.ReferencePeriod,
+		"aggregationmethod":                &sm.AggregationMethod,
+		"limitationsandexceptions":         &sm.LimitationsAndExceptions,
+		"notesfromoriginalsource":          &sm.NotesFromOriginalSource,
+		"generalcomments":                  &sm.GeneralComments,
+		"statisticalconceptandmethodology": &sm.StatisticalConceptAndMethodology,
+		"developmentrelevance":             &sm.DevelopmentRelevance,
+		"relatedsourcelinks":               &sm.RelatedSourceLinks,
+		"otherweblinks":                    &sm.OtherWebLinks,
+		"relatedindicators":                &sm.RelatedIndicators,
+		"licensetype":                      &sm.LicenseType,
+		"licenseurl":                       &sm.LicenseURL,
+		// NOTE: Code is the same as ID
+		"code": new(string),
+	}
+}
+
+func (cm *CountryMetadata) fields() map[string]*string {
+	return map[string]*string{
+		"shortname":                     &cm.ShortName,
+		"tablename":                     &cm.TableName,
+		"longname":                      &cm.LongName,
+		"2alphacode":                    &cm.Alpha2Code,
+		"wb2code":                       &cm.WB2Code,
+		"currencyunit":                  &cm.CurrencyUnit,
+		"specialnotes":                  &cm.SpecialNotes,
+		"region":                        &cm.Region,
+		"incomegroup":                   &cm.IncomeGroup,
+		"lendingcategory":               &cm.LendingCategory,
+		"othergroups":                   &cm.OtherGroups,
+		"systemofnationalaccounts":      &cm.SystemOfNationalAccounts,
+		"nationalaccountsbaseyear":      &cm.NationalAccountsBaseYear,
+		"nationalaccountsreferenceyear": &cm.NationalAccountsReferenceYear,
+		"snapricevaluation":             &cm.SNAPriceValuation,
+		"alternativeconversionfactor":   &cm.AlternativeConversionFactor,
+		"pppsurveyyear":                 &cm.PPPSurveyYear,
+		"balanceofpaymentsmanualinuse":  &cm.BalanceOfPaymentsManualInUse,
+		"externaldebtreportingstatus":   &cm.ExternalDebtReportingStatus,
+		"systemoftrade":                 &cm.SystemOfTrade,
+		"governmentaccountingconcept":   &cm.GovernmentAccountingConcept,
+		"imfdatadisseminationstandard":  &cm.IMFDataDisseminationStandard,
+		"latestpopulationcensus":        &cm.LatestPopulationCensus,
+		"latesthouseholdsurvey":         &cm.LatestHouseholdSurvey,
+		"sourceofmostrecentincomeandexpendituredata": &cm.SourceOfMostRecentIncomeAndExpenditureData,
+		"vitalregistrationcomplete":                  &cm.VitalRegistrationComplete,
+		"latestagriculturalcensus":                   &cm.LatestAgriculturalCensus,
+		"latestindustrialdata":                       &cm.LatestIndustrialData,
+		"latesttradedata":                            &cm.LatestTradeData,
+	}
+}
+
+func (s *metadataSources) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(objectOrArray(data), (*[]*metadataSource)(s))
+}
@@ -0,0 +1,295 @@
+package wbdata
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/jkkitakita/wbdata-go/testutils"
+)
+
+func TestMetadataService_ListSeries(t *testing.T) {
+	client, save := NewTestClient(t, *update)
+	defer save()
+
+	optIgnoreFields := cmpopts.IgnoreFields(
+		PageSummaryWithSourceID{},
+		"Pages",
+		"Total",
+		"LastUpdated",
+	)
+
+	defaultPageParams := &PageParams{
+		Page:    testutils.TestDefaultPage,
+		PerPage: testutils.TestDefaultPerPage,
+	}
+
+	gdp := &SeriesMetadata{
+		ID:                "NY.GDP.MKTP.CD",
+		IndicatorName:     "GDP (current US$)",
+		Topic:             "Economic Policy & Debt: National accounts: US$ at current prices: Aggregate indicators",
+		Source:            "World Bank national accounts data, and OECD National Accounts data files.",
+		Periodicity:       "Annual",
+		AggregationMethod: "Gap-filled total",
+		LongDefinition: "GDP at purchaser's prices is the sum of gross value added by all resident producers in the economy " +
+			"plus any product taxes and minus any subsidies not included in the value of the products.",
+		LimitationsAndExceptions: "Gross domestic product (GDP) may understate economic activity.",
+		LicenseType:              "CC BY-4.0",
+		Others: map[string]string{
+			"Dataset": "World Development Indicators",
+		},
+	}
+
+	type args struct {
+		sourceID  string
+		seriesIDs []string
+		pages     *PageParams
+	}
+	tests := []struct {
+		name       string
+		args       args
+		want       *PageSummaryWithSourceID
+		want1      []*SeriesMetadata
+		wantErr    bool
+		wantErrRes *ErrorResponse
+	}{
+		{
+			name: "success",
+			args: args{
+				sourceID:  testutils.TestDefaultSourceID,
+				seriesIDs: []string{testutils.TestDefaultIndicatorID},
+			},
+			want: &PageSummaryWithSourceID{
+				Page:     1,
+				PerPage:  50,
+				SourceID: "2",
+			},
+			want1:      []*SeriesMetadata{gdp},
+			wantErr:    false,
+			wantErrRes: nil,
+		},
+		{
+			name: "success with multiple series and page params",
+			args: args{
+				sourceID:  testutils.TestDefaultSourceID,
+				seriesIDs: testutils.TestDefaultIndicatorIDs,
+				pages:     defaultPageParams,
+			},
+			want: &PageSummaryWithSourceID{
+				Page:     intOrString(testutils.TestDefaultPage),
+				PerPage:  intOrString(testutils.TestDefaultPerPage),
+				SourceID: "2",
+			},
+			want1: []*SeriesMetadata{
+				gdp,
+				{
+					ID:                "SP.POP.TOTL",
+					IndicatorName:     "Population, total",
+					Periodicity:       "Annual",
+					AggregationMethod: "Sum",
+				},
+			},
+			wantErr:    false,
+			wantErrRes: nil,
+		},
+		{
+			name: "failure because invalid source id",
+			args: args{
+				sourceID:  testutils.TestInvalidSourceID,
+				seriesIDs: []string{testutils.TestDefaultIndicatorID},
+			},
+			want:    nil,
+			want1:   nil,
+			wantErr: true,
+			wantErrRes: &ErrorResponse{
+				URL: fmt.Sprintf(
+					"%s%s/sources/%s/series/%s/metadata?format=json",
+					defaultBaseURL,
+					apiVersion,
+					testutils.TestInvalidSourceID,
+					testutils.TestDefaultIndicatorID,
+				),
+				Code: 200,
+				Message: []ErrorMessage{
+					{
+						ID:    "120",
+						Key:   "Invalid value",
+						Value: "The provided parameter value is not valid",
+					},
+				},
+			},
+		},
+		{
+			name: "failure because source id is empty",
+			args: args{
+				sourceID: "",
+			},
+			want:    nil,
+			want1:   nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &MetadataService{
+				client: client,
+			}
+			got, got1, err := m.ListSeries(tt.args.sourceID, tt.args.seriesIDs, tt.args.pages)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MetadataService.ListSeries() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErrRes != nil {
+				if !reflect.DeepEqual(err, tt.wantErrRes) {
+					t.Errorf("MetadataService.ListSeries() err = %v, wantErrRes %v", err, tt.wantErrRes)
+				}
+			}
+			if tt.want != nil && !cmp.Equal(got, tt.want, nil, optIgnoreFields) {
+				t.Errorf("MetadataService.ListSeries() got = %+v, want %+v", got, tt.want)
+			}
+			if tt.want1 != nil && !reflect.DeepEqual(got1, tt.want1) {
+				t.Errorf("MetadataService.ListSeries() got1 = %+v, want %+v", got1, tt.want1)
+			}
+		})
+	}
+}
+
+func TestMetadataService_GetCountry(t *testing.T) {
+	client, save := NewTestClient(t, *update)
+	defer save()
+
+	type args struct {
+		sourceID  string
+		countryID string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want1   *CountryMetadata
+		wantErr bool
+	}{
+		{
+			name: "success",
+			args: args{
+				sourceID:  testutils.TestDefaultSourceID,
+				countryID: testutils.TestDefaultCountryID,
+			},
+			want1: &CountryMetadata{
+				ID:                       "JPN",
+				ShortName:                "Japan",
+				TableName:                "Japan",
+				LongName:                 "Japan",
+				Alpha2Code:               "JP",
+				CurrencyUnit:             "Japanese yen",
+				Region:                   "East Asia & Pacific",
+				IncomeGroup:              "High income",
+				NationalAccountsBaseYear: "2015",
+				SNAPriceValuation:        "Value added at basic prices (VAB)",
+				LatestPopulationCensus:   "2020",
+			},
+			wantErr: false,
+		},
+		{
+			name: "failure because invalid country id",
+			args: args{
+				sourceID:  testutils.TestDefaultSourceID,
+				countryID: testutils.TestInvalidCountryID,
+			},
+			want1:   nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &MetadataService{
+				client: client,
+			}
+			_, got1, err := m.GetCountry(tt.args.sourceID, tt.args.countryID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MetadataService.GetCountry() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got1, tt.want1) {
+				t.Errorf("MetadataService.GetCountry() got1 = %+v, want %+v", got1, tt.want1)
+			}
+		})
+	}
+}
+
+func TestMetadataService_ListFootnotes(t *testing.T) {
+	client, save := NewTestClient(t, *update)
+	defer save()
+
+	type args struct {
+		sourceID string
+		keys     []*FootnoteKey
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want1   []*FootnoteMetadata
+		wantErr bool
+	}{
+		{
+			name: "success",
+			args: args{
+				sourceID: testutils.TestDefaultSourceID,
+				keys: []*FootnoteKey{
+					{CountryID: "JPN", IndicatorID: "NY.GDP.MKTP.CD", Date: "2019"},
+					{CountryID: "USA", IndicatorID: "SP.POP.TOTL", Date: "YR2019"},
+				},
+			},
+			want1: []*FootnoteMetadata{
+				{
+					FootnoteKey: FootnoteKey{CountryID: "JPN", IndicatorID: "NY.GDP.MKTP.CD", Date: "2019"},
+					Footnote:    "Data are estimates.",
+				},
+				{
+					FootnoteKey: FootnoteKey{CountryID: "USA", IndicatorID: "SP.POP.TOTL", Date: "2019"},
+					Footnote:    "Midyear estimates.",
+					Others: map[string]string{
+						"Source": "Census Bureau",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "failure because keys are empty",
+			args: args{
+				sourceID: testutils.TestDefaultSourceID,
+			},
+			want1:   nil,
+			wantErr: true,
+		},
+		{
+			name: "failure because date is empty",
+			args: args{
+				sourceID: testutils.TestDefaultSourceID,
+				keys: []*FootnoteKey{
+					{CountryID: "JPN", IndicatorID: "NY.GDP.MKTP.CD"},
+				},
+			},
+			want1:   nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &MetadataService{
+				client: client,
+			}
+			_, got1, err := m.ListFootnotes(tt.args.sourceID, tt.args.keys, nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MetadataService.ListFootnotes() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got1, tt.want1) {
+				t.Errorf("MetadataService.ListFootnotes() got1 = %+v, want %+v", got1, tt.want1)
+			}
+		})
+	}
+}
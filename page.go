@@ -73,6 +73,10 @@ func (pages *PageParams) addPageParams(req *http.Request) error {
 func (ios *intOrString) UnmarshalJSON(data []byte) error {
 	var intRegex = regexp.MustCompile(`\d+`)
 	trimData := strings.Trim(string(data), "\"")
+	if trimData == "" {
+		*ios = 0
+		return nil
+	}
 	if intRegex.MatchString(trimData) {
 		if ios != nil {
 			intIos, err := strconv.Atoi(trimData)
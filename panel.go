@@ -0,0 +1,278 @@
+package wbdata
+
+import (
+	"fmt"
+	"sort"
+)
+
+type (
+	// PanelParams contains parameters for NewPanel
+	PanelParams struct {
+		// ByIndicator adds the indicator axis. Otherwise the values should be of a single indicator.
+		ByIndicator bool
+		// DropIncompleteRows drops the countries which have a null or missing value
+		DropIncompleteRows bool
+		// DropIncompleteColumns drops the dates which have a null or missing value.
+		// Rows are dropped before columns if both are specified.
+		DropIncompleteColumns bool
+	}
+
+	// panelCountry is a country of a Panel as in the indicator values
+	panelCountry struct {
+		country         IDAndValue
+		countryiso3code string
+	}
+
+	// panelCell is the position of a value in a Panel
+	panelCell struct {
+		indicatorID string
+		countryID   string
+		date        string
+	}
+
+	// Panel is a dense matrix of indicator values with rows of countries and columns of dates,
+	// and a third axis of indicators
+	Panel struct {
+		// Indicators are the labels of the indicator axis ordered by ID. It has a single indicator without ByIndicator.
+		Indicators []IDAndValue
+		// Countries are the labels of the rows ordered by ID.
+		// The IDs are the ISO3 codes, or the country IDs if the ISO3 codes are empty, same as SeriesKey.CountryID.
+		Countries []IDAndValue
+		// Dates are the labels of the columns ordered by period, e.g. 2019 or 2019Q1
+		Dates []string
+		// Values are indexed by indicator, country and date, e.g. Values[k][i][j] is the value of
+		// Indicators[k] in Countries[i] of Dates[j]. Null and missing values are 0.
+		Values [][][]float64
+		// Nulls is the null mask of Values, which is true for null and missing values
+		Nulls [][][]bool
+
+		// countries and units are kept to restore indicator values by IndicatorValues
+		countries map[string]panelCountry
+		units     map[string]string
+	}
+)
+
+// NewPanel pivots indicator values, e.g. the result of IndicatorValuesService.List, into a Panel.
+// The values should be of the same frequency and a value should not appear twice.
+func NewPanel(indicatorValues []*IndicatorValue, params *PanelParams) (*Panel, error) {
+	if params == nil {
+		params = &PanelParams{}
+	}
+
+	p := &Panel{
+		countries: map[string]panelCountry{},
+		units:     map[string]string{},
+	}
+	indicators := map[string]IDAndValue{}
+	periods := map[string]period{}
+	var frequency FrequencyType
+	cells := map[panelCell]*IndicatorValue{}
+
+	for i, iv := range indicatorValues {
+		pd, err := parsePeriod(iv.Date)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			frequency = pd.frequency
+		}
+		if pd.frequency != frequency {
+			return nil, mixedFrequencyError(iv.Date)
+		}
+
+		if _, ok := indicators[iv.Indicator.ID]; !ok {
+			if len(indicators) > 0 && !params.ByIndicator {
+				return nil, fmt.Errorf(
+					"indicator values should be of a single indicator without ByIndicator, but got %s", iv.Indicator.ID,
+				)
+			}
+			indicators[iv.Indicator.ID] = iv.Indicator
+			p.units[iv.Indicator.ID] = iv.Unit
+		}
+		countryID := iv.countryCode()
+		if _, ok := p.countries[countryID]; !ok {
+			p.countries[countryID] = panelCountry{country: iv.Country, countryiso3code: iv.Countryiso3code}
+		}
+		date := pd.String()
+		periods[date] = pd
+
+		c := panelCell{indicatorID: iv.Indicator.ID, countryID: countryID, date: date}
+		if _, ok := cells[c]; ok {
+			return nil, fmt.Errorf("value of %s in %s of %s should not be duplicated", c.indicatorID, c.countryID, c.date)
+		}
+		cells[c] = iv
+	}
+
+	p.setLabels(indicators, periods)
+	p.fill(cells)
+
+	if params.DropIncompleteRows {
+		p.dropIncompleteRows()
+	}
+	if params.DropIncompleteColumns {
+		p.dropIncompleteColumns()
+	}
+
+	return p, nil
+}
+
+// Matrix returns the values and the null mask of the indicator, indexed by country and date.
+// It returns nil if the panel has no such indicator.
+func (p *Panel) Matrix(indicatorID string) ([][]float64, [][]bool) {
+	for k, indicator := range p.Indicators {
+		if indicator.ID == indicatorID {
+			return p.Values[k], p.Nulls[k]
+		}
+	}
+
+	return nil, nil
+}
+
+// IndicatorValues returns the values of the panel in the long format, ordered by indicator, country and date.
+// Null and missing values are returned as null values, so NewPanel with them returns the same panel.
+func (p *Panel) IndicatorValues() []*IndicatorValue {
+	indicatorValues := make([]*IndicatorValue, 0, len(p.Indicators)*len(p.Countries)*len(p.Dates))
+	for k, indicator := range p.Indicators {
+		for i, country := range p.Countries {
+			c, ok := p.countries[country.ID]
+			if !ok {
+				c = panelCountry{country: IDAndValue{ID: country.ID, Value: country.Value}, countryiso3code: country.ID}
+			}
+			for j, date := range p.Dates {
+				indicatorValues = append(indicatorValues, &IndicatorValue{
+					Indicator:       indicator,
+					Country:         c.country,
+					Countryiso3code: c.countryiso3code,
+					Date:            date,
+					Value:           p.Values[k][i][j],
+					Unit:            p.units[indicator.ID],
+					Null:            p.Nulls[k][i][j],
+				})
+			}
+		}
+	}
+
+	return indicatorValues
+}
+
+// setLabels sets the labels of the axes in order
+func (p *Panel) setLabels(indicators map[string]IDAndValue, periods map[string]period) {
+	for _, indicator := range indicators {
+		p.Indicators = append(p.Indicators, indicator)
+	}
+	sort.Slice(p.Indicators, func(i, j int) bool {
+		return p.Indicators[i].ID < p.Indicators[j].ID
+	})
+	for id, country := range p.countries {
+		p.Countries = append(p.Countries, IDAndValue{ID: id, Value: country.country.Value})
+	}
+	sort.Slice(p.Countries, func(i, j int) bool {
+		return p.Countries[i].ID < p.Countries[j].ID
+	})
+	for date := range periods {
+		p.Dates = append(p.Dates, date)
+	}
+	sort.Slice(p.Dates, func(i, j int) bool {
+		return periods[p.Dates[i]].before(periods[p.Dates[j]])
+	})
+}
+
+// fill sets the values and the null mask from cells
+func (p *Panel) fill(cells map[panelCell]*IndicatorValue) {
+	p.Values = make([][][]float64, len(p.Indicators))
+	p.Nulls = make([][][]bool, len(p.Indicators))
+	for k, indicator := range p.Indicators {
+		p.Values[k] = make([][]float64, len(p.Countries))
+		p.Nulls[k] = make([][]bool, len(p.Countries))
+		for i, country := range p.Countries {
+			p.Values[k][i] = make([]float64, len(p.Dates))
+			p.Nulls[k][i] = make([]bool, len(p.Dates))
+			for j, date := range p.Dates {
+				iv, ok := cells[panelCell{indicatorID: indicator.ID, countryID: country.ID, date: date}]
+				if !ok || iv.IsNull() {
+					p.Nulls[k][i][j] = true
+					continue
+				}
+				p.Values[k][i][j] = iv.Value
+			}
+		}
+	}
+}
+
+func (p *Panel) dropIncompleteRows() {
+	kept := []int{}
+	for i := range p.Countries {
+		if !p.rowHasNull(i) {
+			kept = append(kept, i)
+		}
+	}
+
+	countries := make([]IDAndValue, 0, len(kept))
+	for _, i := range kept {
+		countries = append(countries, p.Countries[i])
+	}
+	p.Countries = countries
+	for k := range p.Indicators {
+		values := make([][]float64, 0, len(kept))
+		nulls := make([][]bool, 0, len(kept))
+		for _, i := range kept {
+			values = append(values, p.Values[k][i])
+			nulls = append(nulls, p.Nulls[k][i])
+		}
+		p.Values[k] = values
+		p.Nulls[k] = nulls
+	}
+}
+
+func (p *Panel) dropIncompleteColumns() {
+	kept := []int{}
+	for j := range p.Dates {
+		if !p.columnHasNull(j) {
+			kept = append(kept, j)
+		}
+	}
+
+	dates := make([]string, 0, len(kept))
+	for _, j := range kept {
+		dates = append(dates, p.Dates[j])
+	}
+	p.Dates = dates
+	for k := range p.Indicators {
+		for i := range p.Countries {
+			values := make([]float64, 0, len(kept))
+			nulls := make([]bool, 0, len(kept))
+			for _, j := range kept {
+				values = append(values, p.Values[k][i][j])
+				nulls = append(nulls, p.Nulls[k][i][j])
+			}
+			p.Values[k][i] = values
+			p.Nulls[k][i] = nulls
+		}
+	}
+}
+
+// rowHasNull reports whether the country of the row i has a null value of any indicator
+func (p *Panel) rowHasNull(i int) bool {
+	for k := range p.Nulls {
+		for _, null := range p.Nulls[k][i] {
+			if null {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// columnHasNull reports whether the date of the column j has a null value of any indicator
+func (p *Panel) columnHasNull(j int) bool {
+	for k := range p.Nulls {
+		for i := range p.Nulls[k] {
+			if p.Nulls[k][i][j] {
+				return true
+			}
+		}
+	}
+
+	return false
+}
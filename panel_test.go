@@ -0,0 +1,157 @@
+package wbdata
+
+import (
+	"reflect"
+	"testing"
+)
+
+func newTestPanelValues() []*IndicatorValue {
+	return []*IndicatorValue{
+		newTestValue("NY.GDP.MKTP.CD", "USA", "2019", 21),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2019", 5),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2018", 4.9),
+		newTestNullValue("NY.GDP.MKTP.CD", "USA", "2018"),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2017", 4.8),
+		newTestValue("NY.GDP.MKTP.CD", "USA", "2017", 19),
+		newTestValue("NY.GDP.MKTP.CD", "DEU", "2019", 3.8),
+	}
+}
+
+func TestNewPanel(t *testing.T) {
+	tests := []struct {
+		name          string
+		values        []*IndicatorValue
+		params        *PanelParams
+		wantCountries []IDAndValue
+		wantDates     []string
+		wantValues    [][]float64
+		wantNulls     [][]bool
+		wantErr       bool
+	}{
+		{
+			name:          "success",
+			values:        newTestPanelValues(),
+			params:        nil,
+			wantCountries: []IDAndValue{{ID: "DEU"}, {ID: "JPN"}, {ID: "USA"}},
+			wantDates:     []string{"2017", "2018", "2019"},
+			wantValues:    [][]float64{{0, 0, 3.8}, {4.8, 4.9, 5}, {19, 0, 21}},
+			wantNulls:     [][]bool{{true, true, false}, {false, false, false}, {false, true, false}},
+		},
+		{
+			name:          "success with dropping incomplete rows",
+			values:        newTestPanelValues(),
+			params:        &PanelParams{DropIncompleteRows: true},
+			wantCountries: []IDAndValue{{ID: "JPN"}},
+			wantDates:     []string{"2017", "2018", "2019"},
+			wantValues:    [][]float64{{4.8, 4.9, 5}},
+			wantNulls:     [][]bool{{false, false, false}},
+		},
+		{
+			name:          "success with dropping incomplete columns",
+			values:        newTestPanelValues(),
+			params:        &PanelParams{DropIncompleteColumns: true},
+			wantCountries: []IDAndValue{{ID: "DEU"}, {ID: "JPN"}, {ID: "USA"}},
+			wantDates:     []string{"2019"},
+			wantValues:    [][]float64{{3.8}, {5}, {21}},
+			wantNulls:     [][]bool{{false}, {false}, {false}},
+		},
+		{
+			name: "failure because of another indicator without ByIndicator",
+			values: append(newTestPanelValues(),
+				newTestValue("SP.POP.TOTL", "JPN", "2019", 126),
+			),
+			wantErr: true,
+		},
+		{
+			name: "failure because of another frequency",
+			values: append(newTestPanelValues(),
+				newTestValue("NY.GDP.MKTP.CD", "JPN", "2019Q1", 1.2),
+			),
+			wantErr: true,
+		},
+		{
+			name: "failure because of a duplicated value",
+			values: append(newTestPanelValues(),
+				newTestValue("NY.GDP.MKTP.CD", "JPN", "2019", 5),
+			),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewPanel(tt.values, tt.params)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewPanel() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got.Countries, tt.wantCountries) {
+				t.Errorf("NewPanel() Countries = %v, want %v", got.Countries, tt.wantCountries)
+			}
+			if !reflect.DeepEqual(got.Dates, tt.wantDates) {
+				t.Errorf("NewPanel() Dates = %v, want %v", got.Dates, tt.wantDates)
+			}
+			values, nulls := got.Matrix("NY.GDP.MKTP.CD")
+			if !reflect.DeepEqual(values, tt.wantValues) {
+				t.Errorf("Panel.Matrix() values = %v, want %v", values, tt.wantValues)
+			}
+			if !reflect.DeepEqual(nulls, tt.wantNulls) {
+				t.Errorf("Panel.Matrix() nulls = %v, want %v", nulls, tt.wantNulls)
+			}
+		})
+	}
+}
+
+func TestPanel_IndicatorValues(t *testing.T) {
+	values := append(newTestPanelValues(),
+		newTestValue("SP.POP.TOTL", "JPN", "2019", 126),
+	)
+	values[0].Country = IDAndValue{ID: "US", Value: "United States"}
+	// the ISO3 code of an aggregate may be empty, and its country ID is used as the row label
+	values = append(values, &IndicatorValue{
+		Indicator: IDAndValue{ID: "SP.POP.TOTL"},
+		Country:   IDAndValue{ID: "XD", Value: "High income"},
+		Date:      "2019",
+		Value:     1200,
+	})
+
+	panel, err := NewPanel(values, &PanelParams{ByIndicator: true})
+	if err != nil {
+		t.Fatalf("NewPanel() error = %v", err)
+	}
+	wantIndicators := []IDAndValue{{ID: "NY.GDP.MKTP.CD"}, {ID: "SP.POP.TOTL"}}
+	if !reflect.DeepEqual(panel.Indicators, wantIndicators) {
+		t.Errorf("NewPanel() Indicators = %v, want %v", panel.Indicators, wantIndicators)
+	}
+	if v, nulls := panel.Matrix("SP.POP.TOTL"); v[1][2] != 126 || !nulls[0][2] {
+		t.Errorf("Panel.Matrix() = %v, %v", v, nulls)
+	}
+	if v, _ := panel.Matrix("invalid_indicator_id"); v != nil {
+		t.Errorf("Panel.Matrix() = %v, want nil", v)
+	}
+
+	long := panel.IndicatorValues()
+	if len(long) != 2*4*3 {
+		t.Fatalf("Panel.IndicatorValues() len = %d, want %d", len(long), 2*4*3)
+	}
+	usa := long[8]
+	if usa.Country.ID != "US" || usa.Countryiso3code != "USA" || usa.Date != "2019" || usa.Value != 21 || usa.IsNull() {
+		t.Errorf("Panel.IndicatorValues()[8] = %+v", usa)
+	}
+	if !long[12].IsNull() || long[12].Indicator.ID != "SP.POP.TOTL" {
+		t.Errorf("Panel.IndicatorValues()[12] = %+v", long[12])
+	}
+	if aggregate := long[23]; aggregate.Country.ID != "XD" || aggregate.Countryiso3code != "" || aggregate.Value != 1200 {
+		t.Errorf("Panel.IndicatorValues()[23] = %+v", aggregate)
+	}
+
+	back, err := NewPanel(long, &PanelParams{ByIndicator: true})
+	if err != nil {
+		t.Fatalf("NewPanel() error = %v", err)
+	}
+	if !reflect.DeepEqual(back, panel) {
+		t.Errorf("NewPanel() with Panel.IndicatorValues() = %+v, want %+v", back, panel)
+	}
+}
@@ -0,0 +1,151 @@
+package wbdata
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	monthsPerYear    = 12
+	quartersPerYear  = 4
+	monthsPerQuarter = monthsPerYear / quartersPerYear
+)
+
+// period is a date of indicator values such as 2019, 2019Q1 or 2019M03
+type period struct {
+	frequency FrequencyType
+	year      int
+	// sub is a quarter (1-4) or a month (1-12), and 1 for yearly
+	sub int
+}
+
+// parsePeriod parses a date of indicator values.
+// Quarters are accepted both as 2019Q1 and as 2019Q01.
+func parsePeriod(date string) (period, error) {
+	if i := strings.Index(date, "Q"); i >= 0 {
+		year, err := strconv.Atoi(date[:i])
+		if err != nil {
+			return period{}, fmt.Errorf("failed to parse date %q: %v", date, err)
+		}
+		quarter, err := strconv.Atoi(date[i+1:])
+		if err != nil || quarter < 1 || quarter > quartersPerYear {
+			return period{}, fmt.Errorf("failed to parse date %q: quarter should be 1 to 4", date)
+		}
+		return period{frequency: FrequencyQuarterly, year: year, sub: quarter}, nil
+	}
+
+	t, err := parseDate(date)
+	if err != nil {
+		return period{}, err
+	}
+	if strings.Contains(date, "M") {
+		return period{frequency: FrequencyMonthly, year: t.Year(), sub: int(t.Month())}, nil
+	}
+
+	return period{frequency: FrequencyYearly, year: t.Year(), sub: 1}, nil
+}
+
+// String returns the date in the API's format
+func (p period) String() string {
+	switch p.frequency {
+	case FrequencyQuarterly:
+		return fmt.Sprintf("%dQ%d", p.year, p.sub)
+	case FrequencyMonthly:
+		return fmt.Sprintf("%dM%02d", p.year, p.sub)
+	default:
+		return strconv.Itoa(p.year)
+	}
+}
+
+// perYear returns the number of periods in a year
+func (p period) perYear() int {
+	switch p.frequency {
+	case FrequencyQuarterly:
+		return quartersPerYear
+	case FrequencyMonthly:
+		return monthsPerYear
+	default:
+		return 1
+	}
+}
+
+// ordinal returns a sequential number of the period which is comparable within the same frequency
+func (p period) ordinal() int {
+	return p.year*p.perYear() + p.sub - 1
+}
+
+// add returns the period n periods after p
+func (p period) add(n int) period {
+	o := p.ordinal() + n
+	perYear := p.perYear()
+	year := o / perYear
+	sub := o % perYear
+	if sub < 0 {
+		year--
+		sub += perYear
+	}
+
+	return period{frequency: p.frequency, year: year, sub: sub + 1}
+}
+
+// before reports whether p is before q
+func (p period) before(q period) bool {
+	if p.year != q.year {
+		return p.year < q.year
+	}
+
+	return p.firstMonth() < q.firstMonth()
+}
+
+// firstMonth returns the first month (1-12) of the period
+func (p period) firstMonth() int {
+	switch p.frequency {
+	case FrequencyQuarterly:
+		return (p.sub-1)*monthsPerQuarter + 1
+	case FrequencyMonthly:
+		return p.sub
+	default:
+		return 1
+	}
+}
+
+// lastMonth returns the last month (1-12) of the period
+func (p period) lastMonth() int {
+	switch p.frequency {
+	case FrequencyQuarterly:
+		return p.sub * monthsPerQuarter
+	case FrequencyMonthly:
+		return p.sub
+	default:
+		return monthsPerYear
+	}
+}
+
+// convert returns the period of the frequency which contains the first month of p,
+// i.e. the period containing p for a coarser frequency, and the first period in p for a finer frequency
+func (p period) convert(frequency FrequencyType) period {
+	month := p.firstMonth()
+	switch frequency {
+	case FrequencyQuarterly:
+		return period{frequency: frequency, year: p.year, sub: (month-1)/monthsPerQuarter + 1}
+	case FrequencyMonthly:
+		return period{frequency: frequency, year: p.year, sub: month}
+	default:
+		return period{frequency: FrequencyYearly, year: p.year, sub: 1}
+	}
+}
+
+// within reports whether p is in the range from start to end inclusive.
+// Periods of different frequencies are compared by months, e.g. 2019Q4 is within 2019 but 2019 is not within 2019Q4.
+func (p period) within(start, end period) bool {
+	first := p.year*monthsPerYear + p.firstMonth()
+	last := p.year*monthsPerYear + p.lastMonth()
+
+	return first >= start.year*monthsPerYear+start.firstMonth() && last <= end.year*monthsPerYear+end.lastMonth()
+}
+
+// mixedFrequencyError returns an error of a date which is not of the same frequency as the other dates
+func mixedFrequencyError(date string) error {
+	return fmt.Errorf("indicator values should be of the same frequency, but got %s", date)
+}
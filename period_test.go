@@ -0,0 +1,140 @@
+package wbdata
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePeriod(t *testing.T) {
+	tests := []struct {
+		name    string
+		date    string
+		want    period
+		wantStr string
+		wantErr bool
+	}{
+		{
+			name:    "success with year",
+			date:    "2019",
+			want:    period{frequency: FrequencyYearly, year: 2019, sub: 1},
+			wantStr: "2019",
+			wantErr: false,
+		},
+		{
+			name:    "success with quarter",
+			date:    "2019Q3",
+			want:    period{frequency: FrequencyQuarterly, year: 2019, sub: 3},
+			wantStr: "2019Q3",
+			wantErr: false,
+		},
+		{
+			name:    "success with zero padded quarter",
+			date:    "2019Q03",
+			want:    period{frequency: FrequencyQuarterly, year: 2019, sub: 3},
+			wantStr: "2019Q3",
+			wantErr: false,
+		},
+		{
+			name:    "success with month",
+			date:    "2019M03",
+			want:    period{frequency: FrequencyMonthly, year: 2019, sub: 3},
+			wantStr: "2019M03",
+			wantErr: false,
+		},
+		{
+			name:    "failure because quarter is out of range",
+			date:    "2019Q5",
+			wantErr: true,
+		},
+		{
+			name:    "failure because invalid date",
+			date:    "invalid_date",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parsePeriod(tt.date)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parsePeriod() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parsePeriod() = %v, want %v", got, tt.want)
+			}
+			if !tt.wantErr && got.String() != tt.wantStr {
+				t.Errorf("period.String() = %v, want %v", got.String(), tt.wantStr)
+			}
+		})
+	}
+}
+
+func TestPeriod_add(t *testing.T) {
+	tests := []struct {
+		name string
+		p    period
+		n    int
+		want string
+	}{
+		{
+			name: "year",
+			p:    period{frequency: FrequencyYearly, year: 2019, sub: 1},
+			n:    -3,
+			want: "2016",
+		},
+		{
+			name: "quarter over a year",
+			p:    period{frequency: FrequencyQuarterly, year: 2019, sub: 4},
+			n:    1,
+			want: "2020Q1",
+		},
+		{
+			name: "month back over a year",
+			p:    period{frequency: FrequencyMonthly, year: 2019, sub: 2},
+			n:    -3,
+			want: "2018M11",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.p.add(tt.n).String(); got != tt.want {
+				t.Errorf("period.add() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPeriod_convert(t *testing.T) {
+	tests := []struct {
+		name      string
+		p         period
+		frequency FrequencyType
+		want      string
+	}{
+		{
+			name:      "month to quarter",
+			p:         period{frequency: FrequencyMonthly, year: 2019, sub: 8},
+			frequency: FrequencyQuarterly,
+			want:      "2019Q3",
+		},
+		{
+			name:      "quarter to year",
+			p:         period{frequency: FrequencyQuarterly, year: 2019, sub: 4},
+			frequency: FrequencyYearly,
+			want:      "2019",
+		},
+		{
+			name:      "quarter to the first month",
+			p:         period{frequency: FrequencyQuarterly, year: 2019, sub: 2},
+			frequency: FrequencyMonthly,
+			want:      "2019M04",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.p.convert(tt.frequency).String(); got != tt.want {
+				t.Errorf("period.convert() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
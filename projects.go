@@ -0,0 +1,279 @@
+package wbdata
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// ProjectStatusActive is the status of active projects
+	ProjectStatusActive ProjectStatus = "Active"
+	// ProjectStatusClosed is the status of closed projects
+	ProjectStatusClosed ProjectStatus = "Closed"
+	// ProjectStatusPipeline is the status of pipeline projects
+	ProjectStatusPipeline ProjectStatus = "Pipeline"
+	// ProjectStatusDropped is the status of dropped projects
+	ProjectStatusDropped ProjectStatus = "Dropped"
+
+	searchDateLayout      = "2006-01-02"
+	searchValuesSeparator = "^"
+	defaultSearchPerPage  = 10
+	projectsPath          = "projects"
+)
+
+type (
+	// ProjectsService ...
+	ProjectsService service
+
+	// ProjectStatus is the status of a project
+	ProjectStatus string
+
+	// ProjectParams contains parameters for ProjectsService.List
+	ProjectParams struct {
+		// CountryCodes are ISO2 codes of countries, e.g. JP
+		CountryCodes []string
+		// Sector is the name of a sector, e.g. Public Administration - Transportation
+		Sector string
+		Status ProjectStatus
+		// ApprovalDateFrom and ApprovalDateTo are the range of the board approval date. A zero value is unbounded.
+		ApprovalDateFrom time.Time
+		ApprovalDateTo   time.Time
+	}
+
+	// Project represents a project of the Projects & Operations API
+	Project struct {
+		ID                 string           `json:"id"`
+		Name               string           `json:"project_name"`
+		Status             string           `json:"status"`
+		RegionName         string           `json:"regionname"`
+		CountryCodes       searchStrings    `json:"countrycode"`
+		CountryNames       searchStrings    `json:"countryshortname"`
+		BoardApprovalDate  string           `json:"boardapprovaldate"`
+		ClosingDate        string           `json:"closingdate"`
+		TotalAmount        searchAmount     `json:"totalamt"`
+		TotalCommitment    searchAmount     `json:"totalcommamt"`
+		LendingInstrument  string           `json:"lendinginstr"`
+		Borrower           string           `json:"borrower"`
+		ImplementingAgency string           `json:"impagency"`
+		Sectors            []*ProjectSector `json:"sector"`
+		URL                string           `json:"url"`
+	}
+
+	// ProjectSector is a sector of a project
+	ProjectSector struct {
+		Name string `json:"Name"`
+	}
+
+	projectsResponse struct {
+		Rows     intOrString `json:"rows"`
+		Offset   intOrString `json:"os"`
+		Page     intOrString `json:"page"`
+		Total    intOrString `json:"total"`
+		Projects projectsMap `json:"projects"`
+	}
+
+	// projectsMap is projects keyed by ID in the order of the response
+	projectsMap []*Project
+
+	// searchStrings is a string or an array of strings in the search APIs
+	searchStrings []string
+
+	// searchAmount is an amount in the search APIs, which can be a string with commas, e.g. "1,000,000"
+	searchAmount float64
+)
+
+// List returns a Response's Summary and Projects with params
+func (p *ProjectsService) List(params *ProjectParams, pages *PageParams) (*PageSummary, []*Project, error) {
+	queryParams, err := params.toQueryParams()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows, offset, err := pages.searchRowsAndOffset()
+	if err != nil {
+		return nil, nil, err
+	}
+	queryParams["rows"] = strconv.Itoa(rows)
+	queryParams["os"] = strconv.Itoa(offset)
+
+	req, err := p.client.newSearchRequest(p.client.ProjectsBaseURL, projectsPath, queryParams)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res := &projectsResponse{}
+	if err := p.client.do(req, res); err != nil {
+		return nil, nil, err
+	}
+
+	return newSearchPageSummary(rows, offset, int(res.Total)), res.Projects, nil
+}
+
+// Countries returns the countries of the project in countries, e.g. the result of CountriesService.List.
+// Countries which are not found, e.g. regional projects, are skipped.
+func (p *Project) Countries(countries []*Country) []*Country {
+	byISO2 := map[string]*Country{}
+	for _, c := range countries {
+		byISO2[c.Iso2Code] = c
+	}
+
+	result := []*Country{}
+	for _, code := range p.CountryCodes {
+		if c, ok := byISO2[code]; ok {
+			result = append(result, c)
+		}
+	}
+
+	return result
+}
+
+// ApprovalDate returns the board approval date. It returns a zero value if the date is empty.
+func (p *Project) ApprovalDate() (time.Time, error) {
+	return parseSearchDate(p.BoardApprovalDate)
+}
+
+func (params *ProjectParams) toQueryParams() (map[string]string, error) {
+	queryParams := map[string]string{}
+	if params == nil {
+		return queryParams, nil
+	}
+
+	queryParams["countrycode_exact"] = strings.Join(params.CountryCodes, searchValuesSeparator)
+	queryParams["sector_exact"] = params.Sector
+	queryParams["status_exact"] = string(params.Status)
+
+	from, to := params.ApprovalDateFrom, params.ApprovalDateTo
+	if !from.IsZero() && !to.IsZero() && from.After(to) {
+		return nil, fmt.Errorf("approval date from should be before to, from: %v to: %v", from, to)
+	}
+	if !from.IsZero() {
+		queryParams["strdate"] = from.Format(searchDateLayout)
+	}
+	if !to.IsZero() {
+		queryParams["enddate"] = to.Format(searchDateLayout)
+	}
+
+	return queryParams, nil
+}
+
+// searchRowsAndOffset converts PageParams to rows and offset of the search APIs
+func (pages *PageParams) searchRowsAndOffset() (int, int, error) {
+	if pages == nil {
+		return defaultSearchPerPage, 0, nil
+	}
+	if pages.Page < 1 {
+		return 0, 0, errors.New("page of params should be larger than 0")
+	}
+	if pages.PerPage < 1 {
+		return 0, 0, errors.New("per_page of params should be larger than 0")
+	}
+
+	return pages.PerPage, (pages.Page - 1) * pages.PerPage, nil
+}
+
+func newSearchPageSummary(rows, offset, total int) *PageSummary {
+	return &PageSummary{
+		Page:    intOrString(offset/rows + 1),
+		Pages:   intOrString(int(math.Ceil(float64(total) / float64(rows)))),
+		PerPage: intOrString(rows),
+		Total:   intOrString(total),
+	}
+}
+
+// parseSearchDate parses a date of the search APIs, e.g. 2019-06-28T00:00:00Z and 2019-06-28
+func parseSearchDate(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+
+	return time.Parse(searchDateLayout, s)
+}
+
+func (m *projectsMap) UnmarshalJSON(data []byte) error {
+	return forEachSearchObject(data, func(key string, raw json.RawMessage) error {
+		p := &Project{}
+		if err := json.Unmarshal(raw, p); err != nil {
+			return err
+		}
+		*m = append(*m, p)
+		return nil
+	})
+}
+
+// forEachSearchObject calls fn with each member of a JSON object in order, which the search APIs use for results keyed by ID
+func forEachSearchObject(data []byte, fn func(key string, raw json.RawMessage) error) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	t, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if t == nil {
+		return nil
+	}
+	if delim, ok := t.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("results should be an object, but got %v", t)
+	}
+
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, _ := t.(string)
+
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return err
+		}
+		if err := fn(key, raw); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ss *searchStrings) UnmarshalJSON(data []byte) error {
+	var values []string
+	if err := json.Unmarshal(data, &values); err == nil {
+		*ss = values
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	if value == "" {
+		*ss = nil
+		return nil
+	}
+	*ss = []string{value}
+
+	return nil
+}
+
+func (sa *searchAmount) UnmarshalJSON(data []byte) error {
+	s := strings.ReplaceAll(strings.Trim(string(data), `"`), ",", "")
+	if s == "" || s == "null" {
+		*sa = 0
+		return nil
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return err
+	}
+	*sa = searchAmount(f)
+
+	return nil
+}
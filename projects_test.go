@@ -0,0 +1,176 @@
+package wbdata
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jkkitakita/wbdata-go/testutils"
+)
+
+func newTestSearchClient(t *testing.T, fixture string, wantQuery url.Values) (*Client, func()) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query(); !reflect.DeepEqual(got, wantQuery) {
+			t.Errorf("query = %v, want %v", got, wantQuery)
+		}
+		http.ServeFile(w, r, filepath.Join("testdata", "search", fixture))
+	}))
+
+	u, _ := url.Parse(server.URL + "/api/v2/")
+	client := NewClient(server.Client(), SetProjectsBaseURL(u), SetDocumentsBaseURL(u))
+
+	return client, server.Close
+}
+
+func TestProjectsService_List(t *testing.T) {
+	type args struct {
+		params *ProjectParams
+		pages  *PageParams
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantQuery url.Values
+		want      *PageSummary
+		want1     []string
+		wantErr   bool
+	}{
+		{
+			name: "success",
+			args: args{
+				params: &ProjectParams{
+					CountryCodes:     []string{"IN", "3A"},
+					Status:           ProjectStatusActive,
+					Sector:           "Social Protection",
+					ApprovalDateFrom: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+					ApprovalDateTo:   time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC),
+				},
+				pages: &PageParams{
+					Page:    testutils.TestDefaultPage,
+					PerPage: testutils.TestDefaultPerPage,
+				},
+			},
+			wantQuery: url.Values{
+				"format":            {"json"},
+				"countrycode_exact": {"IN^3A"},
+				"status_exact":      {"Active"},
+				"sector_exact":      {"Social Protection"},
+				"strdate":           {"2020-01-01"},
+				"enddate":           {"2020-12-31"},
+				"rows":              {"2"},
+				"os":                {"0"},
+			},
+			want: &PageSummary{
+				Page:    1,
+				Pages:   2,
+				PerPage: 2,
+				Total:   3,
+			},
+			want1:   []string{"P178290", "P175140"},
+			wantErr: false,
+		},
+		{
+			name: "success without params",
+			args: args{},
+			wantQuery: url.Values{
+				"format": {"json"},
+				"rows":   {"10"},
+				"os":     {"0"},
+			},
+			want: &PageSummary{
+				Page:    1,
+				Pages:   1,
+				PerPage: 10,
+				Total:   3,
+			},
+			want1:   []string{"P178290", "P175140"},
+			wantErr: false,
+		},
+		{
+			name: "failure because approval date from is after to",
+			args: args{
+				params: &ProjectParams{
+					ApprovalDateFrom: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					ApprovalDateTo:   time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "failure because Page is less than 1",
+			args: args{
+				pages: &PageParams{
+					Page:    testutils.TestInvalidPage,
+					PerPage: testutils.TestDefaultPerPage,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, closeServer := newTestSearchClient(t, "projects.json", tt.wantQuery)
+			defer closeServer()
+
+			p := &ProjectsService{
+				client: client,
+			}
+			got, got1, err := p.List(tt.args.params, tt.args.pages)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ProjectsService.List() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ProjectsService.List() got = %+v, want %+v", got, tt.want)
+			}
+			ids := []string{}
+			for _, project := range got1 {
+				ids = append(ids, project.ID)
+			}
+			if !reflect.DeepEqual(ids, tt.want1) {
+				t.Errorf("ProjectsService.List() got1 = %v, want %v", ids, tt.want1)
+			}
+		})
+	}
+}
+
+func TestProject_Countries(t *testing.T) {
+	client, closeServer := newTestSearchClient(t, "projects.json", url.Values{"format": {"json"}, "rows": {"10"}, "os": {"0"}})
+	defer closeServer()
+
+	_, projects, err := client.Projects.List(nil, nil)
+	if err != nil {
+		t.Fatalf("ProjectsService.List() error = %v", err)
+	}
+
+	india := projects[0]
+	if india.TotalAmount != 4e8 || india.Sectors[0].Name != "Social Protection" {
+		t.Errorf("Project = %+v", india)
+	}
+	if got, _ := india.ApprovalDate(); !got.Equal(time.Date(2020, 12, 17, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Project.ApprovalDate() = %v", got)
+	}
+
+	countries := []*Country{
+		{ID: "IND", Iso2Code: "IN", Name: "India"},
+		{ID: "JPN", Iso2Code: "JP", Name: "Japan"},
+	}
+	if got := india.Countries(countries); !reflect.DeepEqual(got, countries[:1]) {
+		t.Errorf("Project.Countries() = %v, want %v", got, countries[:1])
+	}
+
+	regional := projects[1]
+	if !reflect.DeepEqual([]string(regional.CountryCodes), []string{"3A"}) || regional.TotalCommitment != 1.25e8 {
+		t.Errorf("Project = %+v", regional)
+	}
+	if got := regional.Countries(countries); len(got) != 0 {
+		t.Errorf("Project.Countries() = %v, want empty", got)
+	}
+}
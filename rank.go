@@ -0,0 +1,239 @@
+package wbdata
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+const (
+	// RankCompetition ranks ties the same and skips the following ranks, e.g. 1, 2, 2, 4
+	RankCompetition RankMethod = "competition"
+	// RankDense ranks ties the same without gaps, e.g. 1, 2, 2, 3
+	RankDense RankMethod = "dense"
+
+	quintiles = 5
+)
+
+type (
+	// RankMethod is a method to rank ties
+	RankMethod string
+
+	// RankParams contains parameters for Rank and RankChanges
+	RankParams struct {
+		// Method defaults to RankCompetition
+		Method RankMethod
+		// Ascending ranks the smallest value first. Otherwise the largest value is ranked first.
+		Ascending bool
+		// PeerGroup ranks countries within the groups of the type taken from Country,
+		// i.e. GroupTypeRegion, GroupTypeAdminRegion, GroupTypeIncomeLevel or GroupTypeLendingType.
+		// If it is empty, all countries are ranked together.
+		PeerGroup GroupType
+		// IncludeAggregates ranks aggregates too, which are excluded by default
+		IncludeAggregates bool
+	}
+
+	// Ranking is the rank of a value among the values of a period
+	Ranking struct {
+		Value *IndicatorValue
+		// CountryID is the ISO3 code of the country, or the country ID if it is empty
+		CountryID string
+		// PeerGroupID is the ID of the peer group, which is empty without PeerGroup
+		PeerGroupID string
+		Rank        int
+		// Count is the number of the values ranked together, e.g. 190 of "12th of 190"
+		Count int
+		// Percentile is the percentage of the other values ranked below the value, i.e. 100 for the first
+		Percentile float64
+		// Quintile is from 1 for the top 20% to 5 for the bottom 20%
+		Quintile int
+	}
+
+	// RankChange is the change of the rank of a country between two periods
+	RankChange struct {
+		CountryID string
+		From      *Ranking
+		To        *Ranking
+		// Change is positive if the rank goes up, e.g. 2 from 12th to 10th
+		Change int
+	}
+)
+
+func (m RankMethod) String() string {
+	return string(m)
+}
+
+// Rank ranks the non-null values of the date, ordered by peer group ID, rank and country ID.
+// countries, e.g. the result of CountriesService.List, are used to find aggregates and peer groups.
+// Values of countries which are not in countries are ranked only with IncludeAggregates and without PeerGroup,
+// and countries should not be empty unless IncludeAggregates is set.
+// It returns an error if the date of a value is invalid.
+func Rank(indicatorValues []*IndicatorValue, date string, countries []*Country, params *RankParams) ([]*Ranking, error) {
+	if params == nil {
+		params = &RankParams{}
+	}
+	method := params.Method
+	if method == "" {
+		method = RankCompetition
+	}
+	if method != RankCompetition && method != RankDense {
+		return nil, fmt.Errorf("unsupported rank method: %s", method)
+	}
+	if params.PeerGroup != "" {
+		if _, err := peerGroupID(&Country{}, params.PeerGroup); err != nil {
+			return nil, err
+		}
+	}
+	if !params.IncludeAggregates && len(countries) == 0 {
+		return nil, errors.New("countries should be specified to exclude aggregates")
+	}
+	target, err := parsePeriod(date)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := map[string]*Country{}
+	for _, c := range countries {
+		byID[c.ID] = c
+		if c.Iso2Code != "" {
+			byID[c.Iso2Code] = c
+		}
+	}
+	aggregates := NewAggregateIDs(countries)
+
+	groups := map[string][]*Ranking{}
+	for _, iv := range indicatorValues {
+		p, err := parsePeriod(iv.Date)
+		if err != nil {
+			return nil, err
+		}
+		if iv.IsNull() || p != target {
+			continue
+		}
+		country := byID[iv.countryCode()]
+		if country == nil {
+			country = byID[iv.Country.ID]
+		}
+		if !params.IncludeAggregates && (country == nil || iv.IsAggregate(aggregates)) {
+			continue
+		}
+
+		groupID := ""
+		if params.PeerGroup != "" {
+			if country == nil {
+				continue
+			}
+			if groupID, _ = peerGroupID(country, params.PeerGroup); groupID == "" {
+				continue
+			}
+		}
+		groups[groupID] = append(groups[groupID], &Ranking{Value: iv, CountryID: iv.countryCode(), PeerGroupID: groupID})
+	}
+
+	groupIDs := make([]string, 0, len(groups))
+	for id := range groups {
+		groupIDs = append(groupIDs, id)
+	}
+	sort.Strings(groupIDs)
+
+	rankings := []*Ranking{}
+	for _, id := range groupIDs {
+		rankings = append(rankings, rankGroup(groups[id], method, params.Ascending)...)
+	}
+
+	return rankings, nil
+}
+
+// RankChanges returns the changes of the ranks from a date to another date, ordered by peer group ID,
+// the rank of the latter date and country ID. Countries which are not ranked in both dates are skipped.
+func RankChanges(indicatorValues []*IndicatorValue, from, to string, countries []*Country, params *RankParams) ([]*RankChange, error) {
+	fromRankings, err := Rank(indicatorValues, from, countries, params)
+	if err != nil {
+		return nil, err
+	}
+	toRankings, err := Rank(indicatorValues, to, countries, params)
+	if err != nil {
+		return nil, err
+	}
+
+	byCountry := make(map[string]*Ranking, len(fromRankings))
+	for _, r := range fromRankings {
+		byCountry[r.CountryID] = r
+	}
+	changes := []*RankChange{}
+	for _, r := range toRankings {
+		f, ok := byCountry[r.CountryID]
+		if !ok {
+			continue
+		}
+		changes = append(changes, &RankChange{
+			CountryID: r.CountryID,
+			From:      f,
+			To:        r,
+			Change:    f.Rank - r.Rank,
+		})
+	}
+
+	return changes, nil
+}
+
+// rankGroup ranks the values of a peer group
+func rankGroup(rankings []*Ranking, method RankMethod, ascending bool) []*Ranking {
+	sort.Slice(rankings, func(i, j int) bool {
+		vi, vj := rankings[i].Value.Value, rankings[j].Value.Value
+		if vi != vj {
+			if ascending {
+				return vi < vj
+			}
+			return vi > vj
+		}
+		return rankings[i].CountryID < rankings[j].CountryID
+	})
+
+	n := len(rankings)
+	dense := 0
+	for start := 0; start < n; {
+		// ties are from start to end exclusive
+		end := start + 1
+		for end < n && rankings[end].Value.Value == rankings[start].Value.Value {
+			end++
+		}
+		dense++
+
+		rank := start + 1
+		if method == RankDense {
+			rank = dense
+		}
+		percentile := float64(percentScale)
+		if n > 1 {
+			percentile = float64(n-end) / float64(n-1) * percentScale
+		}
+		quintile := int(math.Ceil(float64(quintiles*(start+1)) / float64(n)))
+		for _, r := range rankings[start:end] {
+			r.Rank = rank
+			r.Count = n
+			r.Percentile = percentile
+			r.Quintile = quintile
+		}
+		start = end
+	}
+
+	return rankings
+}
+
+// peerGroupID returns the ID of the group of the type which the country belongs to
+func peerGroupID(country *Country, groupType GroupType) (string, error) {
+	switch groupType {
+	case GroupTypeRegion:
+		return country.Region.ID, nil
+	case GroupTypeAdminRegion:
+		return country.AdminRegion.ID, nil
+	case GroupTypeIncomeLevel:
+		return country.IncomeLevel.ID, nil
+	case GroupTypeLendingType:
+		return country.LendingType.ID, nil
+	default:
+		return "", fmt.Errorf("unsupported peer group: %s", groupType)
+	}
+}
@@ -0,0 +1,181 @@
+package wbdata
+
+import (
+	"reflect"
+	"testing"
+)
+
+func newTestRankCountries() []*Country {
+	return []*Country{
+		{ID: "JPN", Iso2Code: "JP", Region: CountryRegion{ID: "EAS"}, IncomeLevel: IncomeLevel{ID: "HIC"}},
+		{ID: "CHN", Iso2Code: "CN", Region: CountryRegion{ID: "EAS"}, IncomeLevel: IncomeLevel{ID: "UMC"}},
+		{ID: "USA", Iso2Code: "US", Region: CountryRegion{ID: "NAC"}, IncomeLevel: IncomeLevel{ID: "HIC"}},
+		{ID: "DEU", Iso2Code: "DE", Region: CountryRegion{ID: "ECS"}, IncomeLevel: IncomeLevel{ID: "HIC"}},
+		{ID: "IND", Iso2Code: "IN", Region: CountryRegion{ID: "SAS"}, IncomeLevel: IncomeLevel{ID: "LMC"}},
+		{ID: "WLD", Iso2Code: "1W", Region: CountryRegion{ID: aggregateRegionID}},
+	}
+}
+
+func newTestRankValues() []*IndicatorValue {
+	return []*IndicatorValue{
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2019", 5),
+		newTestValue("NY.GDP.MKTP.CD", "CHN", "2019", 14),
+		newTestValue("NY.GDP.MKTP.CD", "USA", "2019", 21),
+		newTestValue("NY.GDP.MKTP.CD", "DEU", "2019", 5),
+		newTestValue("NY.GDP.MKTP.CD", "IND", "2019", 3),
+		newTestValue("NY.GDP.MKTP.CD", "WLD", "2019", 87),
+		newTestNullValue("NY.GDP.MKTP.CD", "GBR", "2019"),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2010", 6),
+		newTestValue("NY.GDP.MKTP.CD", "CHN", "2010", 6),
+		newTestValue("NY.GDP.MKTP.CD", "USA", "2010", 15),
+		newTestValue("NY.GDP.MKTP.CD", "DEU", "2010", 3),
+	}
+}
+
+func TestRank(t *testing.T) {
+	type want struct {
+		countryID  string
+		groupID    string
+		rank       int
+		count      int
+		percentile float64
+		quintile   int
+	}
+	tests := []struct {
+		name    string
+		params  *RankParams
+		want    []want
+		wantErr bool
+	}{
+		{
+			name:   "competition rank",
+			params: nil,
+			want: []want{
+				{countryID: "USA", rank: 1, count: 5, percentile: 100, quintile: 1},
+				{countryID: "CHN", rank: 2, count: 5, percentile: 75, quintile: 2},
+				{countryID: "DEU", rank: 3, count: 5, percentile: 25, quintile: 3},
+				{countryID: "JPN", rank: 3, count: 5, percentile: 25, quintile: 3},
+				{countryID: "IND", rank: 5, count: 5, percentile: 0, quintile: 5},
+			},
+		},
+		{
+			name:   "dense rank in ascending order with aggregates",
+			params: &RankParams{Method: RankDense, Ascending: true, IncludeAggregates: true},
+			want: []want{
+				{countryID: "IND", rank: 1, count: 6, percentile: 100, quintile: 1},
+				{countryID: "DEU", rank: 2, count: 6, percentile: 60, quintile: 2},
+				{countryID: "JPN", rank: 2, count: 6, percentile: 60, quintile: 2},
+				{countryID: "CHN", rank: 3, count: 6, percentile: 40, quintile: 4},
+				{countryID: "USA", rank: 4, count: 6, percentile: 20, quintile: 5},
+				{countryID: "WLD", rank: 5, count: 6, percentile: 0, quintile: 5},
+			},
+		},
+		{
+			name:   "peer groups of income levels",
+			params: &RankParams{PeerGroup: GroupTypeIncomeLevel},
+			want: []want{
+				{countryID: "USA", groupID: "HIC", rank: 1, count: 3, percentile: 100, quintile: 2},
+				{countryID: "DEU", groupID: "HIC", rank: 2, count: 3, percentile: 0, quintile: 4},
+				{countryID: "JPN", groupID: "HIC", rank: 2, count: 3, percentile: 0, quintile: 4},
+				{countryID: "IND", groupID: "LMC", rank: 1, count: 1, percentile: 100, quintile: 5},
+				{countryID: "CHN", groupID: "UMC", rank: 1, count: 1, percentile: 100, quintile: 5},
+			},
+		},
+		{
+			name:    "failure because of an unsupported method",
+			params:  &RankParams{Method: "invalid_method"},
+			wantErr: true,
+		},
+		{
+			name:    "failure because of an unsupported peer group",
+			params:  &RankParams{PeerGroup: GroupTypeOther},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Rank(newTestRankValues(), "2019", newTestRankCountries(), tt.params)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Rank() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			gotWant := make([]want, 0, len(got))
+			for _, r := range got {
+				gotWant = append(gotWant, want{
+					countryID:  r.CountryID,
+					groupID:    r.PeerGroupID,
+					rank:       r.Rank,
+					count:      r.Count,
+					percentile: r.Percentile,
+					quintile:   r.Quintile,
+				})
+			}
+			if !reflect.DeepEqual(gotWant, tt.want) {
+				t.Errorf("Rank() = %+v, want %+v", gotWant, tt.want)
+			}
+		})
+	}
+}
+
+func TestRank_countriesNotListed(t *testing.T) {
+	values := []*IndicatorValue{
+		newTestValue("NY.GDP.MKTP.CD", "ARB", "2019", 100),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2019", 50),
+	}
+
+	if _, err := Rank(values, "2019", nil, nil); err == nil {
+		t.Errorf("Rank() error = nil, want error")
+	}
+
+	// ARB is not in countries, so it may be an aggregate
+	got, err := Rank(values, "2019", newTestRankCountries(), nil)
+	if err != nil {
+		t.Fatalf("Rank() error = %v", err)
+	}
+	if len(got) != 1 || got[0].CountryID != "JPN" || got[0].Rank != 1 || got[0].Count != 1 {
+		t.Errorf("Rank() = %+v, want only JPN", got)
+	}
+
+	got, err = Rank(values, "2019", nil, &RankParams{IncludeAggregates: true})
+	if err != nil {
+		t.Fatalf("Rank() error = %v", err)
+	}
+	if len(got) != 2 || got[0].CountryID != "ARB" || got[1].CountryID != "JPN" {
+		t.Errorf("Rank() = %+v, want ARB and JPN", got)
+	}
+
+	invalid := append(newTestRankValues(), newTestValue("NY.GDP.MKTP.CD", "GBR", "2019X1", 3))
+	if _, err := Rank(invalid, "2019", newTestRankCountries(), nil); err == nil {
+		t.Errorf("Rank() error = nil, want error")
+	}
+}
+
+func TestRankChanges(t *testing.T) {
+	got, err := RankChanges(newTestRankValues(), "2010", "2019", newTestRankCountries(), nil)
+	if err != nil {
+		t.Fatalf("RankChanges() error = %v", err)
+	}
+
+	// 2010: USA 1, CHN 2, JPN 2, DEU 4 and IND is not ranked
+	want := map[string]int{"USA": 0, "CHN": 0, "DEU": 1, "JPN": -1}
+	gotChanges := map[string]int{}
+	for _, c := range got {
+		gotChanges[c.CountryID] = c.Change
+		if c.From.Value.Date != "2010" || c.To.Value.Date != "2019" {
+			t.Errorf("RankChange = %+v", c)
+		}
+	}
+	if !reflect.DeepEqual(gotChanges, want) {
+		t.Errorf("RankChanges() = %v, want %v", gotChanges, want)
+	}
+	if got[0].CountryID != "USA" {
+		t.Errorf("RankChanges()[0] = %+v, want USA", got[0])
+	}
+
+	if _, err := RankChanges(newTestRankValues(), "invalid_date", "2019", newTestRankCountries(), nil); err == nil {
+		t.Errorf("RankChanges() error = nil, want error")
+	}
+}
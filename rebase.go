@@ -0,0 +1,223 @@
+package wbdata
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// DeflatorGDP is the indicator ID of the GDP deflator
+	DeflatorGDP = "NY.GDP.DEFL.ZS"
+	// DeflatorCPI is the indicator ID of the consumer price index
+	DeflatorCPI = "FP.CPI.TOTL"
+
+	// UnitIndex is the unit of series rebased to index numbers
+	UnitIndex = "index"
+
+	rebaseSuffix  = "IDX"
+	deflateSuffix = "REAL"
+
+	defaultDeflatePerPage = 1000
+)
+
+type (
+	// DeflateParams contains parameters for IndicatorValuesService.Deflate
+	DeflateParams struct {
+		// CountryIDs are the countries to fetch. If it is empty, all countries are fetched.
+		CountryIDs []string
+		// FilterParams should contain Base if it is a date range, because the deflator is rebased to Base
+		FilterParams *FilterParams
+		// PerPage is the number of values requested at a time. Defaults to 1000.
+		PerPage int
+		// DeflatorID is the price index to deflate by. Defaults to DeflatorGDP.
+		DeflatorID string
+		// Base is the base year, or the base period of the frequency of the series, e.g. 2015.
+		// The values of a country are null if its deflator is missing or has no value of Base.
+		Base string
+	}
+)
+
+// Rebase returns a Series of index numbers which are 100 in the base period, e.g. 2015.
+// For quarterly and monthly series a base year is accepted, and the mean of the year is 100.
+// It returns an error if the value of the base period is null, missing or zero.
+func (s *Series) Rebase(base string) (*Series, error) {
+	basePeriod, err := parsePeriod(base)
+	if err != nil {
+		return nil, err
+	}
+	baseValue, err := s.baseValue(basePeriod)
+	if err != nil {
+		return nil, err
+	}
+	base = basePeriod.String()
+
+	rebased := s.derive(rebaseSuffix+base, 0, fmt.Sprintf("index %s = 100", base), UnitIndex,
+		func(_ period, v float64) (float64, bool) {
+			return v / baseValue * percentScale, true
+		})
+	rebased.Base = base
+
+	return rebased, nil
+}
+
+// Deflate returns a Series of the values in prices of the base period, e.g. constant 2015 US$ from current US$.
+// The deflator is a price index of the same country and frequency, e.g. DeflatorGDP or DeflatorCPI,
+// which is rebased to the base period in the same way as Rebase.
+// A value is null if the deflator of the period is null, missing or zero.
+func (s *Series) Deflate(deflator *Series, base string) (*Series, error) {
+	if err := s.checkDeflator(deflator); err != nil {
+		return nil, err
+	}
+	basePeriod, err := parsePeriod(base)
+	if err != nil {
+		return nil, err
+	}
+	baseValue, err := deflator.baseValue(basePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deflate(deflator, basePeriod, baseValue), nil
+}
+
+// Deflate fetches the values of the indicator and the deflator of the countries,
+// and returns the deflated series keyed by their derived keys.
+// The values of a country are null if its deflator is missing or has no value of the base.
+func (i *IndicatorValuesService) Deflate(indicatorID string, params *DeflateParams) (map[SeriesKey]*Series, error) {
+	if params == nil || params.Base == "" {
+		return nil, errors.New("base should be specified")
+	}
+	basePeriod, err := parsePeriod(params.Base)
+	if err != nil {
+		return nil, err
+	}
+	deflatorID := params.DeflatorID
+	if deflatorID == "" {
+		deflatorID = DeflatorGDP
+	}
+	perPage := params.PerPage
+	if perPage == 0 {
+		perPage = defaultDeflatePerPage
+	}
+
+	indicatorValues := []*IndicatorValue{}
+	for _, id := range []string{indicatorID, deflatorID} {
+		ivs, err := i.listAll(params.CountryIDs, id, params.FilterParams, perPage)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch %s: %v", id, err)
+		}
+		indicatorValues = append(indicatorValues, ivs...)
+	}
+	series, err := GroupSeries(indicatorValues)
+	if err != nil {
+		return nil, err
+	}
+
+	return deflateSeries(series, indicatorID, deflatorID, basePeriod)
+}
+
+// deflateSeries deflates the series of the indicator by the series of the deflator of the same countries.
+// The values of a country are null if its deflator is missing or has no value of the base.
+func deflateSeries(series map[SeriesKey]*Series, indicatorID, deflatorID string, base period) (map[SeriesKey]*Series, error) {
+	deflated := map[SeriesKey]*Series{}
+	for key, s := range series {
+		if key.IndicatorID != indicatorID {
+			continue
+		}
+		deflatorKey := SeriesKey{IndicatorID: deflatorID, CountryID: key.CountryID}
+		deflator, ok := series[deflatorKey]
+		if !ok {
+			deflator = &Series{Key: deflatorKey, Indicator: IDAndValue{ID: deflatorID}, Frequency: s.Frequency}
+		}
+		if err := s.checkDeflator(deflator); err != nil {
+			return nil, err
+		}
+		if err := s.checkBase(base); err != nil {
+			return nil, err
+		}
+		// baseValue is 0 if the deflator is missing or has no value of the base, and then the values are null
+		baseValue, _ := deflator.baseValue(base)
+		d := s.deflate(deflator, base, baseValue)
+		deflated[d.Key] = d
+	}
+
+	return deflated, nil
+}
+
+// deflate returns a Series deflated by the deflator whose value of the base period is baseValue.
+// All of the values are null if baseValue is 0.
+func (s *Series) deflate(deflator *Series, base period, baseValue float64) *Series {
+	deflatorName := deflator.Indicator.Value
+	if deflatorName == "" {
+		deflatorName = deflator.Indicator.ID
+	}
+	deflated := s.derive(deflateSuffix+base.String(), 0, fmt.Sprintf("deflated by %s, %s prices", deflatorName, base), "",
+		func(p period, v float64) (float64, bool) {
+			d, ok := deflator.valueAt(p)
+			if !ok || d == 0 || baseValue == 0 {
+				return 0, false
+			}
+			return v / (d / baseValue), true
+		})
+	deflated.Base = base.String()
+	deflated.DeflatorID = deflator.Key.IndicatorID
+
+	return deflated
+}
+
+// checkDeflator returns an error if the deflator is not of the country and the frequency of the series
+func (s *Series) checkDeflator(deflator *Series) error {
+	if deflator.Key.CountryID != s.Key.CountryID {
+		return fmt.Errorf("deflator should be of %s, but got %s", s.Key.CountryID, deflator.Key)
+	}
+	if deflator.Frequency != s.Frequency {
+		return fmt.Errorf("deflator %s should be of the frequency of %s", deflator.Key, s.Key)
+	}
+
+	return nil
+}
+
+// checkBase returns an error if the base period is neither a year nor of the frequency of the series
+func (s *Series) checkBase(p period) error {
+	if p.frequency != FrequencyYearly && p.frequency != s.Frequency {
+		return fmt.Errorf("base %s should be a year or of the frequency of %s", p, s.Key)
+	}
+
+	return nil
+}
+
+// baseValue returns the value of the base period, or the mean of the base year for quarterly and monthly series
+func (s *Series) baseValue(p period) (float64, error) {
+	if err := s.checkBase(p); err != nil {
+		return 0, err
+	}
+	var values []float64
+	if p.frequency == s.Frequency {
+		if v, ok := s.valueAt(p); ok {
+			values = append(values, v)
+		}
+	} else {
+		first := period{frequency: s.Frequency, year: p.year, sub: 1}
+		for j := 0; j < s.perYear(); j++ {
+			if v, ok := s.valueAt(first.add(j)); ok {
+				values = append(values, v)
+			}
+		}
+		if len(values) != s.perYear() {
+			values = nil
+		}
+	}
+	if len(values) == 0 {
+		return 0, fmt.Errorf("series %s has no value of the base %s", s.Key, p)
+	}
+
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	if sum == 0 {
+		return 0, fmt.Errorf("value of the base %s of %s should not be zero", p, s.Key)
+	}
+
+	return sum / float64(len(values)), nil
+}
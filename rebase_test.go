@@ -0,0 +1,274 @@
+package wbdata
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jkkitakita/wbdata-go/testutils"
+)
+
+func TestSeries_Rebase(t *testing.T) {
+	nan := math.NaN()
+	yearly, err := NewSeries([]*IndicatorValue{
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2015", 80),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2016", 100),
+		newTestNullValue("NY.GDP.MKTP.CD", "JPN", "2017"),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2018", 0),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2019", 125),
+	})
+	if err != nil {
+		t.Fatalf("NewSeries() error = %v", err)
+	}
+	quarterly, err := NewSeries([]*IndicatorValue{
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2019Q1", 10),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2019Q2", 20),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2019Q3", 30),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2019Q4", 40),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2020Q1", 50),
+	})
+	if err != nil {
+		t.Fatalf("NewSeries() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		series   *Series
+		base     string
+		wantID   string
+		wantBase string
+		want     []float64
+		wantErr  bool
+	}{
+		{
+			name:     "base year",
+			series:   yearly,
+			base:     "2016",
+			wantID:   "NY.GDP.MKTP.CD.IDX2016",
+			wantBase: "2016",
+			want:     []float64{80, 100, nan, 0, 125},
+		},
+		{
+			name:     "base quarter",
+			series:   quarterly,
+			base:     "2019Q02",
+			wantID:   "NY.GDP.MKTP.CD.IDX2019Q2",
+			wantBase: "2019Q2",
+			want:     []float64{50, 100, 150, 200, 250},
+		},
+		{
+			name:     "mean of the base year",
+			series:   quarterly,
+			base:     "2019",
+			wantID:   "NY.GDP.MKTP.CD.IDX2019",
+			wantBase: "2019",
+			want:     []float64{40, 80, 120, 160, 200},
+		},
+		{
+			name:    "failure because the base is null",
+			series:  yearly,
+			base:    "2017",
+			wantErr: true,
+		},
+		{
+			name:    "failure because the base is zero",
+			series:  yearly,
+			base:    "2018",
+			wantErr: true,
+		},
+		{
+			name:    "failure because the base year is incomplete",
+			series:  quarterly,
+			base:    "2020",
+			wantErr: true,
+		},
+		{
+			name:    "failure because the base is finer than the series",
+			series:  yearly,
+			base:    "2019Q1",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.series.Rebase(tt.base)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Series.Rebase() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Key.IndicatorID != tt.wantID || got.Indicator.ID != tt.wantID || got.Base != tt.wantBase {
+				t.Errorf("Series.Rebase() = %+v, want ID %v and base %v", got, tt.wantID, tt.wantBase)
+			}
+			if got.Values[0].Unit != UnitIndex {
+				t.Errorf("Series.Rebase() Unit = %v, want %v", got.Values[0].Unit, UnitIndex)
+			}
+			if gotValues := seriesValues(got); !equalValues(gotValues, tt.want) {
+				t.Errorf("Series.Rebase() values = %v, want %v", gotValues, tt.want)
+			}
+		})
+	}
+}
+
+func TestSeries_Deflate(t *testing.T) {
+	nan := math.NaN()
+	nominal, err := NewSeries([]*IndicatorValue{
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2017", 90),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2018", 110),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2019", 132),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2020", 140),
+	})
+	if err != nil {
+		t.Fatalf("NewSeries() error = %v", err)
+	}
+	deflatorValues := []*IndicatorValue{
+		newTestValue(DeflatorGDP, "JPN", "2017", 90),
+		newTestValue(DeflatorGDP, "JPN", "2018", 100),
+		newTestValue(DeflatorGDP, "JPN", "2019", 120),
+	}
+	deflatorValues[0].Indicator.Value = "GDP deflator"
+	deflator, err := NewSeries(deflatorValues)
+	if err != nil {
+		t.Fatalf("NewSeries() error = %v", err)
+	}
+
+	got, err := nominal.Deflate(deflator, "2018")
+	if err != nil {
+		t.Fatalf("Series.Deflate() error = %v", err)
+	}
+	// 2020 has no deflator
+	if want := []float64{100, 110, 110, nan}; !equalValues(seriesValues(got), want) {
+		t.Errorf("Series.Deflate() values = %v, want %v", seriesValues(got), want)
+	}
+	if got.Key.IndicatorID != "NY.GDP.MKTP.CD.REAL2018" || got.Base != "2018" || got.DeflatorID != DeflatorGDP {
+		t.Errorf("Series.Deflate() = %+v", got)
+	}
+	if got.Indicator.Value != "NY.GDP.MKTP.CD, deflated by GDP deflator, 2018 prices" {
+		t.Errorf("Series.Deflate() Indicator = %+v", got.Indicator)
+	}
+
+	if _, err := nominal.Deflate(deflator, "2020"); err == nil {
+		t.Errorf("Series.Deflate() error = nil, want error")
+	}
+	usa, err := NewSeries([]*IndicatorValue{newTestValue(DeflatorGDP, "USA", "2018", 100)})
+	if err != nil {
+		t.Fatalf("NewSeries() error = %v", err)
+	}
+	if _, err := nominal.Deflate(usa, "2018"); err == nil {
+		t.Errorf("Series.Deflate() error = nil, want error")
+	}
+}
+
+func TestDeflateSeries(t *testing.T) {
+	nan := math.NaN()
+	series, err := GroupSeries([]*IndicatorValue{
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2018", 100),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2019", 120),
+		newTestValue("NY.GDP.MKTP.CD", "USA", "2018", 200),
+		newTestValue("NY.GDP.MKTP.CD", "DEU", "2018", 300),
+		newTestValue(DeflatorGDP, "JPN", "2018", 100),
+		newTestValue(DeflatorGDP, "JPN", "2019", 120),
+		newTestNullValue(DeflatorGDP, "DEU", "2018"),
+	})
+	if err != nil {
+		t.Fatalf("GroupSeries() error = %v", err)
+	}
+	base, err := parsePeriod("2018")
+	if err != nil {
+		t.Fatalf("parsePeriod() error = %v", err)
+	}
+
+	got, err := deflateSeries(series, "NY.GDP.MKTP.CD", DeflatorGDP, base)
+	if err != nil {
+		t.Fatalf("deflateSeries() error = %v", err)
+	}
+	// USA has no deflator, and the deflator of DEU is null in the base year
+	want := map[string][]float64{"JPN": {100, 100}, "USA": {nan}, "DEU": {nan}}
+	if len(got) != len(want) {
+		t.Fatalf("deflateSeries() len = %d, want %d", len(got), len(want))
+	}
+	for countryID, w := range want {
+		s := got[SeriesKey{IndicatorID: "NY.GDP.MKTP.CD.REAL2018", CountryID: countryID}]
+		if s == nil || !equalValues(seriesValues(s), w) || s.DeflatorID != DeflatorGDP {
+			t.Errorf("deflateSeries() %s = %+v, want %v", countryID, s, w)
+		}
+	}
+
+	quarter, err := parsePeriod("2018Q1")
+	if err != nil {
+		t.Fatalf("parsePeriod() error = %v", err)
+	}
+	if _, err := deflateSeries(series, "NY.GDP.MKTP.CD", DeflatorGDP, quarter); err == nil {
+		t.Errorf("deflateSeries() error = nil, want error")
+	}
+}
+
+func TestIndicatorValuesService_Deflate(t *testing.T) {
+	client, save := NewTestClient(t, *update)
+	defer save()
+
+	i := &IndicatorValuesService{
+		client: client,
+	}
+	params := &DeflateParams{
+		CountryIDs: testutils.TestDefaultCountryIDs,
+		FilterParams: &FilterParams{
+			FilterParamsType: FilterParamsDateRange,
+			DateParam: &DateParam{
+				DateRange: &DateRange{
+					Start: testutils.TestDefaultDateStart,
+					End:   testutils.TestDefaultDateEnd,
+				},
+			},
+		},
+		PerPage: testutils.TestDefaultPerPage,
+		Base:    testutils.TestDefaultDateStart,
+	}
+
+	got, err := i.Deflate("NY.GDP.MKTP.CD", params)
+	if err != nil {
+		t.Fatalf("IndicatorValuesService.Deflate() error = %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("IndicatorValuesService.Deflate() len = %d, want 2", len(got))
+	}
+
+	japan := got[SeriesKey{IndicatorID: "NY.GDP.MKTP.CD.REAL2018", CountryID: "JPN"}]
+	if japan == nil || japan.Base != "2018" || japan.DeflatorID != DeflatorGDP {
+		t.Fatalf("IndicatorValuesService.Deflate() JPN = %+v", japan)
+	}
+	deflator2018, deflator2019 := 102.2, 102.8
+	wantJapan := []float64{4954806619995.16, 5081769542379.77 / (deflator2019 / deflator2018)}
+	if !equalValues(seriesValues(japan), wantJapan) {
+		t.Errorf("IndicatorValuesService.Deflate() JPN values = %v, want %v", seriesValues(japan), wantJapan)
+	}
+	// the deflator of USA is null in 2019
+	usa := got[SeriesKey{IndicatorID: "NY.GDP.MKTP.CD.REAL2018", CountryID: "USA"}]
+	if wantUSA := []float64{20611861000000, math.NaN()}; usa == nil || !equalValues(seriesValues(usa), wantUSA) {
+		t.Errorf("IndicatorValuesService.Deflate() USA = %+v, want %v", usa, wantUSA)
+	}
+
+	// USA has no deflator of the base 2019, so its values are null
+	params.Base = "2019"
+	got, err = i.Deflate("NY.GDP.MKTP.CD", params)
+	if err != nil {
+		t.Fatalf("IndicatorValuesService.Deflate() error = %v", err)
+	}
+	japan = got[SeriesKey{IndicatorID: "NY.GDP.MKTP.CD.REAL2019", CountryID: "JPN"}]
+	wantJapan = []float64{4954806619995.16 / (deflator2018 / deflator2019), 5081769542379.77}
+	if japan == nil || !equalValues(seriesValues(japan), wantJapan) {
+		t.Errorf("IndicatorValuesService.Deflate() JPN = %+v, want %v", japan, wantJapan)
+	}
+	usa = got[SeriesKey{IndicatorID: "NY.GDP.MKTP.CD.REAL2019", CountryID: "USA"}]
+	if wantUSA := []float64{math.NaN(), math.NaN()}; usa == nil || !equalValues(seriesValues(usa), wantUSA) {
+		t.Errorf("IndicatorValuesService.Deflate() USA = %+v, want %v", usa, wantUSA)
+	}
+
+	if _, err := i.Deflate(testutils.TestInvalidIndicatorID, params); err == nil {
+		t.Errorf("IndicatorValuesService.Deflate() error = nil, want error")
+	}
+	if _, err := i.Deflate("NY.GDP.MKTP.CD", nil); err == nil {
+		t.Errorf("IndicatorValuesService.Deflate() error = nil, want error")
+	}
+}
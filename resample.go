@@ -0,0 +1,189 @@
+package wbdata
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// ResampleSum sums the values in a period of a coarser frequency, e.g. for flows such as exports
+	ResampleSum ResampleMethod = "sum"
+	// ResampleMean averages the values in a period of a coarser frequency, e.g. for rates such as interest rates
+	ResampleMean ResampleMethod = "mean"
+	// ResampleEndOfPeriod takes the value of the last period in a period of a coarser frequency, e.g. for stocks such as reserves.
+	// The value is null when the last period, e.g. December for a year, is null or missing.
+	ResampleEndOfPeriod ResampleMethod = "end"
+	// ResampleFlatSplit splits a value evenly into the periods of a finer frequency, so that they sum to the value
+	ResampleFlatSplit ResampleMethod = "flat"
+	// ResampleLinear places a value at the last period of a finer frequency in it,
+	// and interpolates linearly from the value of the previous period.
+	// The periods before the first value, and after a null or missing value, are null.
+	ResampleLinear ResampleMethod = "linear"
+)
+
+type (
+	// ResampleMethod is a method to convert a series to another frequency
+	ResampleMethod string
+
+	// ResampleParams contains parameters for Series.Resample
+	ResampleParams struct {
+		// Frequency is the frequency to convert to, i.e. FrequencyMonthly, FrequencyQuarterly or FrequencyYearly
+		Frequency FrequencyType
+		// Method should be ResampleSum, ResampleMean or ResampleEndOfPeriod to a coarser frequency,
+		// and ResampleFlatSplit or ResampleLinear to a finer frequency
+		Method ResampleMethod
+		// MinCount is the minimum number of non-null values in a period of a coarser frequency.
+		// The value of a period under it is null. 0 requires all of the values, e.g. 12 months for a year.
+		MinCount int
+	}
+)
+
+func (m ResampleMethod) String() string {
+	return string(m)
+}
+
+// Resample returns a Series of the frequency converted by the method.
+// Converted values are copies of the values of the series,
+// and values of a finer frequency have ObsStatusImputed except the values placed by ResampleLinear.
+func (s *Series) Resample(params *ResampleParams) (*Series, error) {
+	if params == nil {
+		return nil, errors.New("resample params should not be nil")
+	}
+	switch params.Frequency {
+	case FrequencyMonthly, FrequencyQuarterly, FrequencyYearly:
+	default:
+		return nil, fmt.Errorf("unsupported frequency to resample to: %d", params.Frequency)
+	}
+	if params.MinCount < 0 {
+		return nil, fmt.Errorf("min count should be 0 or larger, but got %d", params.MinCount)
+	}
+
+	from := period{frequency: s.Frequency}.perYear()
+	to := period{frequency: params.Frequency}.perYear()
+	switch {
+	case to < from:
+		switch params.Method {
+		case ResampleSum, ResampleMean, ResampleEndOfPeriod:
+			return s.aggregateFrequency(params), nil
+		}
+	case to > from:
+		switch params.Method {
+		case ResampleFlatSplit, ResampleLinear:
+			return s.disaggregateFrequency(params), nil
+		}
+	default:
+		return s.withValues(append([]*IndicatorValue{}, s.Values...), append([]period{}, s.periods...)), nil
+	}
+
+	return nil, fmt.Errorf("unsupported resample method from %d to %d periods a year: %s", from, to, params.Method)
+}
+
+// ResampleSeries resamples each series, e.g. the result of GroupSeries
+func ResampleSeries(series map[SeriesKey]*Series, params *ResampleParams) (map[SeriesKey]*Series, error) {
+	resampled := make(map[SeriesKey]*Series, len(series))
+	for key, s := range series {
+		r, err := s.Resample(params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resample %s: %v", key, err)
+		}
+		resampled[key] = r
+	}
+
+	return resampled, nil
+}
+
+// aggregateFrequency aggregates the values of the series to the coarser frequency of params
+func (s *Series) aggregateFrequency(params *ResampleParams) *Series {
+	aggregated := s.withValues(nil, nil)
+	aggregated.Frequency = params.Frequency
+	minCount := params.MinCount
+	if minCount == 0 {
+		minCount = period{frequency: s.Frequency}.perYear() / period{frequency: params.Frequency}.perYear()
+	}
+
+	for start := 0; start < len(s.Values); {
+		p := s.periods[start].convert(params.Frequency)
+		end := start
+		for end < len(s.Values) && s.periods[end].convert(params.Frequency) == p {
+			end++
+		}
+
+		var sum float64
+		count := 0
+		for _, iv := range s.Values[start:end] {
+			if iv.IsNull() {
+				continue
+			}
+			sum += iv.Value
+			count++
+		}
+
+		last := s.Values[end-1]
+		hasLast := !last.IsNull() && s.periods[end-1].lastMonth() == p.lastMonth()
+		iv := resampledValue(last, p)
+		if count >= minCount && (params.Method != ResampleEndOfPeriod || hasLast) {
+			switch params.Method {
+			case ResampleSum:
+				iv.Value = sum
+			case ResampleMean:
+				iv.Value = sum / float64(count)
+			case ResampleEndOfPeriod:
+				iv.Value = last.Value
+			}
+			iv.Null = false
+		}
+		aggregated.Values = append(aggregated.Values, iv)
+		aggregated.periods = append(aggregated.periods, p)
+		start = end
+	}
+
+	return aggregated
+}
+
+// disaggregateFrequency disaggregates the values of the series to the finer frequency of params
+func (s *Series) disaggregateFrequency(params *ResampleParams) *Series {
+	disaggregated := s.withValues(nil, nil)
+	disaggregated.Frequency = params.Frequency
+	n := period{frequency: params.Frequency}.perYear() / s.perYear()
+
+	for i, iv := range s.Values {
+		var previous *IndicatorValue
+		if i > 0 && s.periods[i-1].add(1) == s.periods[i] && !s.Values[i-1].IsNull() {
+			previous = s.Values[i-1]
+		}
+
+		first := s.periods[i].convert(params.Frequency)
+		for k := 0; k < n; k++ {
+			p := first.add(k)
+			v := resampledValue(iv, p)
+			switch {
+			case iv.IsNull():
+			case params.Method == ResampleFlatSplit:
+				v.Value, v.Null = iv.Value/float64(n), false
+				v.ObsStatus = ObsStatusImputed
+			case k == n-1:
+				v.Value, v.Null = iv.Value, false
+				v.ObsStatus = iv.ObsStatus
+			case previous != nil:
+				v.Value = previous.Value + (iv.Value-previous.Value)*float64(k+1)/float64(n)
+				v.Null = false
+				v.ObsStatus = ObsStatusImputed
+			}
+			disaggregated.Values = append(disaggregated.Values, v)
+			disaggregated.periods = append(disaggregated.periods, p)
+		}
+	}
+
+	return disaggregated
+}
+
+// resampledValue returns a null copy of iv dated the period
+func resampledValue(iv *IndicatorValue, p period) *IndicatorValue {
+	resampled := *iv
+	resampled.Date = p.String()
+	resampled.Value = 0
+	resampled.ObsStatus = ""
+	resampled.Null = true
+
+	return &resampled
+}
@@ -0,0 +1,206 @@
+package wbdata
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestSeries_Resample(t *testing.T) {
+	nan := math.NaN()
+	// 2019M05 is null and 2019Q3 has only 2019M07
+	monthly, err := NewSeries([]*IndicatorValue{
+		newTestValue("TOT", "JPN", "2019M01", 1),
+		newTestValue("TOT", "JPN", "2019M02", 2),
+		newTestValue("TOT", "JPN", "2019M03", 3),
+		newTestValue("TOT", "JPN", "2019M04", 4),
+		newTestNullValue("TOT", "JPN", "2019M05"),
+		newTestValue("TOT", "JPN", "2019M06", 6),
+		newTestValue("TOT", "JPN", "2019M07", 7),
+	})
+	if err != nil {
+		t.Fatalf("NewSeries() error = %v", err)
+	}
+	// 2019Q4 is null
+	quarterly, err := NewSeries([]*IndicatorValue{
+		newTestValue("TOT", "JPN", "2019Q1", 1),
+		newTestValue("TOT", "JPN", "2019Q2", 2),
+		newTestValue("TOT", "JPN", "2019Q3", 3),
+		newTestNullValue("TOT", "JPN", "2019Q4"),
+		newTestValue("TOT", "JPN", "2020Q1", 5),
+		newTestValue("TOT", "JPN", "2020Q2", 6),
+		newTestValue("TOT", "JPN", "2020Q3", 7),
+		newTestValue("TOT", "JPN", "2020Q4", 8),
+	})
+	if err != nil {
+		t.Fatalf("NewSeries() error = %v", err)
+	}
+	// 2020 is null
+	yearly, err := NewSeries([]*IndicatorValue{
+		newTestValue("TOT", "JPN", "2018", 40),
+		newTestValue("TOT", "JPN", "2019", 80),
+		newTestNullValue("TOT", "JPN", "2020"),
+		newTestValue("TOT", "JPN", "2021", 100),
+	})
+	if err != nil {
+		t.Fatalf("NewSeries() error = %v", err)
+	}
+	quarters := []string{
+		"2018Q1", "2018Q2", "2018Q3", "2018Q4", "2019Q1", "2019Q2", "2019Q3", "2019Q4",
+		"2020Q1", "2020Q2", "2020Q3", "2020Q4", "2021Q1", "2021Q2", "2021Q3", "2021Q4",
+	}
+
+	tests := []struct {
+		name      string
+		series    *Series
+		params    *ResampleParams
+		wantDates []string
+		want      []float64
+		wantErr   bool
+	}{
+		{
+			name:      "sum of complete quarters",
+			series:    monthly,
+			params:    &ResampleParams{Frequency: FrequencyQuarterly, Method: ResampleSum},
+			wantDates: []string{"2019Q1", "2019Q2", "2019Q3"},
+			want:      []float64{6, nan, nan},
+		},
+		{
+			name:      "mean with min count",
+			series:    monthly,
+			params:    &ResampleParams{Frequency: FrequencyQuarterly, Method: ResampleMean, MinCount: 1},
+			wantDates: []string{"2019Q1", "2019Q2", "2019Q3"},
+			want:      []float64{2, 5, 7},
+		},
+		{
+			name:      "end of period with min count",
+			series:    monthly,
+			params:    &ResampleParams{Frequency: FrequencyQuarterly, Method: ResampleEndOfPeriod, MinCount: 2},
+			wantDates: []string{"2019Q1", "2019Q2", "2019Q3"},
+			want:      []float64{3, 6, nan},
+		},
+		{
+			name:      "end of period with the last period null",
+			series:    quarterly,
+			params:    &ResampleParams{Frequency: FrequencyYearly, Method: ResampleEndOfPeriod, MinCount: 1},
+			wantDates: []string{"2019", "2020"},
+			want:      []float64{nan, 8},
+		},
+		{
+			name:      "sum to a year",
+			series:    monthly,
+			params:    &ResampleParams{Frequency: FrequencyYearly, Method: ResampleSum, MinCount: 6},
+			wantDates: []string{"2019"},
+			want:      []float64{23},
+		},
+		{
+			name:      "flat split",
+			series:    yearly,
+			params:    &ResampleParams{Frequency: FrequencyQuarterly, Method: ResampleFlatSplit},
+			wantDates: quarters,
+			want:      []float64{10, 10, 10, 10, 20, 20, 20, 20, nan, nan, nan, nan, 25, 25, 25, 25},
+		},
+		{
+			name:      "linear interpolation",
+			series:    yearly,
+			params:    &ResampleParams{Frequency: FrequencyQuarterly, Method: ResampleLinear},
+			wantDates: quarters,
+			want:      []float64{nan, nan, nan, 40, 50, 60, 70, 80, nan, nan, nan, nan, nan, nan, nan, 100},
+		},
+		{
+			name:      "same frequency",
+			series:    yearly,
+			params:    &ResampleParams{Frequency: FrequencyYearly, Method: ResampleSum},
+			wantDates: []string{"2018", "2019", "2020", "2021"},
+			want:      []float64{40, 80, nan, 100},
+		},
+		{
+			name:    "failure because of a method to a finer frequency",
+			series:  monthly,
+			params:  &ResampleParams{Frequency: FrequencyYearly, Method: ResampleLinear},
+			wantErr: true,
+		},
+		{
+			name:    "failure because of a method to a coarser frequency",
+			series:  yearly,
+			params:  &ResampleParams{Frequency: FrequencyMonthly, Method: ResampleMean},
+			wantErr: true,
+		},
+		{
+			name:    "failure because of an unknown frequency",
+			series:  yearly,
+			params:  &ResampleParams{Frequency: FrequencyUnknown, Method: ResampleSum},
+			wantErr: true,
+		},
+		{
+			name:    "failure because of a negative min count",
+			series:  monthly,
+			params:  &ResampleParams{Frequency: FrequencyYearly, Method: ResampleSum, MinCount: -1},
+			wantErr: true,
+		},
+		{
+			name:    "failure because of nil params",
+			series:  monthly,
+			params:  nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.series.Resample(tt.params)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Series.Resample() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Frequency != tt.params.Frequency || got.Key != tt.series.Key {
+				t.Errorf("Series.Resample() = %+v", got)
+			}
+			if !reflect.DeepEqual(got.Dates(), tt.wantDates) {
+				t.Errorf("Series.Resample() dates = %v, want %v", got.Dates(), tt.wantDates)
+			}
+			if gotValues := seriesValues(got); !equalValues(gotValues, tt.want) {
+				t.Errorf("Series.Resample() values = %v, want %v", gotValues, tt.want)
+			}
+		})
+	}
+
+	linear, err := yearly.Resample(&ResampleParams{Frequency: FrequencyQuarterly, Method: ResampleLinear})
+	if err != nil {
+		t.Fatalf("Series.Resample() error = %v", err)
+	}
+	if v, _ := linear.At("2019Q1"); !v.IsImputed() {
+		t.Errorf("Series.Resample() 2019Q1 = %+v, want imputed", v)
+	}
+	if v, _ := linear.At("2019Q4"); v.IsImputed() || v == yearly.Values[1] {
+		t.Errorf("Series.Resample() 2019Q4 = %+v, want a copy which is not imputed", v)
+	}
+}
+
+func TestResampleSeries(t *testing.T) {
+	series, err := GroupSeries([]*IndicatorValue{
+		newTestValue("TOT", "JPN", "2019M01", 1),
+		newTestValue("TOT", "JPN", "2019M02", 2),
+		newTestValue("TOT", "USA", "2019Q1", 3),
+	})
+	if err != nil {
+		t.Fatalf("GroupSeries() error = %v", err)
+	}
+
+	got, err := ResampleSeries(series, &ResampleParams{Frequency: FrequencyQuarterly, Method: ResampleMean, MinCount: 1})
+	if err != nil {
+		t.Fatalf("ResampleSeries() error = %v", err)
+	}
+	if japan := got[SeriesKey{IndicatorID: "TOT", CountryID: "JPN"}]; !equalValues(seriesValues(japan), []float64{1.5}) {
+		t.Errorf("ResampleSeries() JPN = %v, want [1.5]", seriesValues(japan))
+	}
+	if usa := got[SeriesKey{IndicatorID: "TOT", CountryID: "USA"}]; !equalValues(seriesValues(usa), []float64{3}) {
+		t.Errorf("ResampleSeries() USA = %v, want [3]", seriesValues(usa))
+	}
+
+	if _, err := ResampleSeries(series, &ResampleParams{Frequency: FrequencyYearly, Method: ResampleLinear}); err == nil {
+		t.Errorf("ResampleSeries() error = nil, want error")
+	}
+}
@@ -0,0 +1,724 @@
+package wbdata
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// SDMXDimensionFrequency is the SDMX dimension ID for frequency
+	SDMXDimensionFrequency = "FREQ"
+	// SDMXDimensionRefArea is the SDMX dimension ID for countries
+	SDMXDimensionRefArea = "REF_AREA"
+	// SDMXDimensionIndicator is the SDMX dimension ID for indicators
+	SDMXDimensionIndicator = "INDICATOR"
+	// SDMXDimensionTimePeriod is the SDMX dimension ID for dates
+	SDMXDimensionTimePeriod = "TIME_PERIOD"
+	// SDMXAttributeSource is the SDMX attribute ID for sources
+	SDMXAttributeSource = "SOURCE"
+	// SDMXAttributeUnit is the SDMX attribute ID for units
+	SDMXAttributeUnit = "UNIT_MEASURE"
+	// SDMXAttributeDecimals is the SDMX attribute ID for decimals
+	SDMXAttributeDecimals = "DECIMALS"
+	// SDMXAttributeObsStatus is the SDMX attribute ID for observation status
+	SDMXAttributeObsStatus = "OBS_STATUS"
+	// SDMXAttributeComment is the SDMX attribute ID for footnotes
+	SDMXAttributeComment = "COMMENT_OBS"
+	// SDMXMeasureObsValue is the SDMX measure ID for values
+	SDMXMeasureObsValue = "OBS_VALUE"
+
+	// SDMXDefaultAgencyID is the default agency ID of SDMX messages
+	SDMXDefaultAgencyID = "WB"
+	// SDMXDefaultStructureID is the default data structure ID of SDMX messages
+	SDMXDefaultStructureID = "WDI"
+	// SDMXDefaultStructureVersion is the default data structure version of SDMX messages
+	SDMXDefaultStructureVersion = "1.0"
+
+	sdmxJSONSchema       = "https://json.sdmx.org/2.0.0/sdmx-json-data-schema.json"
+	sdmxMLNamespace      = "http://www.sdmx.org/resources/sdmxml/schemas/v2_1/"
+	sdmxDefaultLanguage  = "en"
+	sdmxKeySeparator     = ":"
+	sdmxCodelistPrefix   = "CL_"
+	sdmxDefaultMessageID = "IREF"
+)
+
+type (
+	// SDMXParams contains parameters for SDMX messages
+	SDMXParams struct {
+		// ID is the message ID
+		ID string
+		// AgencyID is the maintenance agency of the data structure and codelists
+		AgencyID string
+		// StructureID is the ID of the data structure
+		StructureID string
+		// StructureVersion is the version of the data structure
+		StructureVersion string
+		// Prepared is the time the message was prepared. Defaults to now
+		Prepared time.Time
+		// Language is the language of names. Defaults to en
+		Language string
+	}
+
+	// SDMXCode is a code of a codelist
+	SDMXCode struct {
+		ID   string
+		Name string
+	}
+
+	// SDMXCodelist is a codelist of a dimension or an attribute
+	SDMXCodelist struct {
+		ID    string
+		Name  string
+		Codes []*SDMXCode
+	}
+
+	// SDMXCodelists contains the codelists derived from the catalogs
+	SDMXCodelists struct {
+		Frequencies *SDMXCodelist
+		Countries   *SDMXCodelist
+		Indicators  *SDMXCodelist
+		Sources     *SDMXCodelist
+
+		// indicatorSources maps indicator IDs to source IDs
+		indicatorSources map[string]string
+	}
+
+	sdmxSeries struct {
+		key          []string
+		source       string
+		unit         string
+		decimals     string
+		observations []*IndicatorValueWithFootnote
+	}
+)
+
+var sdmxFrequencyCodes = map[FrequencyType]*SDMXCode{
+	FrequencyYearly:    {ID: "A", Name: "Annual"},
+	FrequencyQuarterly: {ID: "Q", Name: "Quarterly"},
+	FrequencyMonthly:   {ID: "M", Name: "Monthly"},
+}
+
+// NewSDMXCodelists returns codelists derived from countries, indicators and sources
+func NewSDMXCodelists(countries []*Country, indicators []*Indicator, sources []*Source) *SDMXCodelists {
+	codelists := &SDMXCodelists{
+		Frequencies: &SDMXCodelist{
+			ID:   sdmxCodelistPrefix + SDMXDimensionFrequency,
+			Name: "Frequency",
+			Codes: []*SDMXCode{
+				sdmxFrequencyCodes[FrequencyYearly],
+				sdmxFrequencyCodes[FrequencyQuarterly],
+				sdmxFrequencyCodes[FrequencyMonthly],
+			},
+		},
+		Countries: &SDMXCodelist{
+			ID:   sdmxCodelistPrefix + SDMXDimensionRefArea,
+			Name: "Reference area",
+		},
+		Indicators: &SDMXCodelist{
+			ID:   sdmxCodelistPrefix + SDMXDimensionIndicator,
+			Name: "Indicator",
+		},
+		Sources: &SDMXCodelist{
+			ID:   sdmxCodelistPrefix + SDMXAttributeSource,
+			Name: "Source",
+		},
+		indicatorSources: map[string]string{},
+	}
+
+	for _, country := range countries {
+		codelists.Countries.Codes = append(codelists.Countries.Codes, &SDMXCode{ID: country.ID, Name: country.Name})
+	}
+	for _, indicator := range indicators {
+		codelists.Indicators.Codes = append(codelists.Indicators.Codes, &SDMXCode{ID: indicator.ID, Name: indicator.Name})
+		if indicator.Source != nil {
+			codelists.indicatorSources[indicator.ID] = indicator.Source.ID
+		}
+	}
+	for _, source := range sources {
+		codelists.Sources.Codes = append(codelists.Sources.Codes, &SDMXCode{ID: source.ID, Name: source.Name})
+	}
+
+	return codelists
+}
+
+// CodeName returns the name of the code. It returns an empty string if the code is not in the codelist.
+func (cl *SDMXCodelist) CodeName(id string) string {
+	if cl == nil {
+		return ""
+	}
+	for _, code := range cl.Codes {
+		if code.ID == id {
+			return code.Name
+		}
+	}
+
+	return ""
+}
+
+func (params *SDMXParams) withDefaults() *SDMXParams {
+	p := SDMXParams{}
+	if params != nil {
+		p = *params
+	}
+	if p.ID == "" {
+		p.ID = sdmxDefaultMessageID
+	}
+	if p.AgencyID == "" {
+		p.AgencyID = SDMXDefaultAgencyID
+	}
+	if p.StructureID == "" {
+		p.StructureID = SDMXDefaultStructureID
+	}
+	if p.StructureVersion == "" {
+		p.StructureVersion = SDMXDefaultStructureVersion
+	}
+	if p.Prepared.IsZero() {
+		p.Prepared = time.Now()
+	}
+	if p.Language == "" {
+		p.Language = sdmxDefaultLanguage
+	}
+
+	return &p
+}
+
+func (params *SDMXParams) structureURN() string {
+	return fmt.Sprintf(
+		"urn:sdmx:org.sdmx.infomodel.datastructure.DataStructure=%s:%s(%s)",
+		params.AgencyID,
+		params.StructureID,
+		params.StructureVersion,
+	)
+}
+
+// sdmxTimePeriod returns a date in the SDMX time period format such as 2019, 2019-Q1 or 2019-03
+func sdmxTimePeriod(p period) string {
+	switch p.frequency {
+	case FrequencyQuarterly:
+		return fmt.Sprintf("%d-Q%d", p.year, p.sub)
+	case FrequencyMonthly:
+		return fmt.Sprintf("%d-%02d", p.year, p.sub)
+	default:
+		return strconv.Itoa(p.year)
+	}
+}
+
+// groupSDMXSeries groups indicator values by frequency, country and indicator.
+// Series are sorted by key and observations by date.
+func groupSDMXSeries(
+	indicatorValues []*IndicatorValueWithFootnote,
+	codelists *SDMXCodelists,
+) ([]*sdmxSeries, map[*IndicatorValueWithFootnote]period, error) {
+	seriesByKey := map[string]*sdmxSeries{}
+	periods := map[*IndicatorValueWithFootnote]period{}
+
+	for _, iv := range indicatorValues {
+		p, err := parsePeriod(iv.Date)
+		if err != nil {
+			return nil, nil, err
+		}
+		periods[iv] = p
+		if iv.Countryiso3code == "" {
+			return nil, nil, fmt.Errorf("Countryiso3code of %s should be specified for %s", iv.Country.ID, SDMXDimensionRefArea)
+		}
+
+		key := []string{sdmxFrequencyCodes[p.frequency].ID, iv.Countryiso3code, iv.Indicator.ID}
+		joinedKey := strings.Join(key, sdmxKeySeparator)
+		s, ok := seriesByKey[joinedKey]
+		if !ok {
+			s = &sdmxSeries{
+				key:      key,
+				unit:     iv.Unit,
+				decimals: strconv.Itoa(int(iv.Decimal)),
+			}
+			if codelists != nil {
+				s.source = codelists.indicatorSources[iv.Indicator.ID]
+			}
+			seriesByKey[joinedKey] = s
+		}
+		s.observations = append(s.observations, iv)
+	}
+
+	series := make([]*sdmxSeries, 0, len(seriesByKey))
+	for _, s := range seriesByKey {
+		sort.SliceStable(s.observations, func(i, j int) bool {
+			return periods[s.observations[i]].before(periods[s.observations[j]])
+		})
+		series = append(series, s)
+	}
+	sort.Slice(series, func(i, j int) bool {
+		return strings.Join(series[i].key, sdmxKeySeparator) < strings.Join(series[j].key, sdmxKeySeparator)
+	})
+
+	return series, periods, nil
+}
+
+type (
+	sdmxJSONMessage struct {
+		Meta sdmxJSONMeta `json:"meta"`
+		Data sdmxJSONData `json:"data"`
+	}
+
+	sdmxJSONMeta struct {
+		Schema           string       `json:"schema"`
+		ID               string       `json:"id"`
+		Test             bool         `json:"test"`
+		Prepared         string       `json:"prepared"`
+		ContentLanguages []string     `json:"contentLanguages"`
+		Sender           sdmxJSONItem `json:"sender"`
+	}
+
+	sdmxJSONData struct {
+		Structures []*sdmxJSONStructure `json:"structures"`
+		DataSets   []*sdmxJSONDataSet   `json:"dataSets"`
+	}
+
+	sdmxJSONStructure struct {
+		Structure  string             `json:"structure"`
+		Dimensions sdmxJSONComponents `json:"dimensions"`
+		Attributes sdmxJSONComponents `json:"attributes"`
+		Measures   sdmxJSONMeasures   `json:"measures"`
+	}
+
+	sdmxJSONComponents struct {
+		DataSet     []*sdmxJSONComponent `json:"dataSet"`
+		Series      []*sdmxJSONComponent `json:"series"`
+		Observation []*sdmxJSONComponent `json:"observation"`
+	}
+
+	sdmxJSONMeasures struct {
+		Observation []*sdmxJSONComponent `json:"observation"`
+	}
+
+	sdmxJSONComponent struct {
+		ID          string          `json:"id"`
+		Name        string          `json:"name"`
+		KeyPosition *int            `json:"keyPosition,omitempty"`
+		Roles       []string        `json:"roles,omitempty"`
+		Values      []*sdmxJSONItem `json:"values,omitempty"`
+	}
+
+	sdmxJSONItem struct {
+		ID    string `json:"id,omitempty"`
+		Name  string `json:"name,omitempty"`
+		Value string `json:"value,omitempty"`
+	}
+
+	sdmxJSONDataSet struct {
+		Structure int                            `json:"structure"`
+		Action    string                         `json:"action"`
+		Series    map[string]*sdmxJSONSeriesData `json:"series"`
+	}
+
+	sdmxJSONSeriesData struct {
+		Attributes   []*int                   `json:"attributes"`
+		Observations map[string][]interface{} `json:"observations"`
+	}
+)
+
+// sdmxJSONValues collects the distinct values of a component in order of appearance
+type sdmxJSONValues struct {
+	values []*sdmxJSONItem
+	index  map[string]int
+}
+
+func newSDMXJSONValues() *sdmxJSONValues {
+	return &sdmxJSONValues{index: map[string]int{}}
+}
+
+// add adds a value and returns its index. An empty ID is not added and returns nil.
+func (v *sdmxJSONValues) add(item *sdmxJSONItem) *int {
+	key := item.ID + item.Value
+	if key == "" {
+		return nil
+	}
+	i, ok := v.index[key]
+	if !ok {
+		i = len(v.values)
+		v.index[key] = i
+		v.values = append(v.values, item)
+	}
+
+	return &i
+}
+
+// WriteSDMXJSON writes indicator values with footnote as an SDMX-JSON 2.0 data message.
+// Frequencies, countries and indicators are mapped to the FREQ, REF_AREA and INDICATOR dimensions,
+// ObsStatus to the OBS_STATUS attribute and footnotes to the COMMENT_OBS attribute.
+// Names of codes are taken from codelists, and from the indicator values if a code is missing.
+// REF_AREA is the Countryiso3code, and it returns an error if the Countryiso3code of a value is empty.
+func WriteSDMXJSON(
+	w io.Writer,
+	indicatorValues []*IndicatorValueWithFootnote,
+	codelists *SDMXCodelists,
+	params *SDMXParams,
+) error {
+	params = params.withDefaults()
+	if codelists == nil {
+		codelists = NewSDMXCodelists(nil, nil, nil)
+	}
+
+	series, periods, err := groupSDMXSeries(indicatorValues, codelists)
+	if err != nil {
+		return err
+	}
+
+	frequencies := newSDMXJSONValues()
+	countries := newSDMXJSONValues()
+	indicators := newSDMXJSONValues()
+	timePeriods := newSDMXJSONValues()
+	sources := newSDMXJSONValues()
+	units := newSDMXJSONValues()
+	decimals := newSDMXJSONValues()
+	obsStatuses := newSDMXJSONValues()
+	comments := newSDMXJSONValues()
+
+	dataSet := &sdmxJSONDataSet{
+		Structure: 0,
+		Action:    "Information",
+		Series:    map[string]*sdmxJSONSeriesData{},
+	}
+	for _, s := range series {
+		first := s.observations[0]
+		frequency := frequencies.add(&sdmxJSONItem{ID: s.key[0], Name: codelists.Frequencies.CodeName(s.key[0])})
+		country := countries.add(&sdmxJSONItem{ID: s.key[1], Name: sdmxCodeName(codelists.Countries, s.key[1], first.Country.Value)})
+		indicator := indicators.add(&sdmxJSONItem{ID: s.key[2], Name: sdmxCodeName(codelists.Indicators, s.key[2], first.Indicator.Value)})
+		seriesKey := fmt.Sprintf("%d:%d:%d", *frequency, *country, *indicator)
+
+		seriesData := &sdmxJSONSeriesData{
+			Attributes: []*int{
+				sources.add(&sdmxJSONItem{ID: s.source, Name: codelists.Sources.CodeName(s.source)}),
+				units.add(&sdmxJSONItem{Value: s.unit}),
+				decimals.add(&sdmxJSONItem{Value: s.decimals}),
+			},
+			Observations: map[string][]interface{}{},
+		}
+		for _, iv := range s.observations {
+			var value interface{}
+			if !iv.IsNull() {
+				value = iv.Value
+			}
+			timePeriod := timePeriods.add(&sdmxJSONItem{Value: sdmxTimePeriod(periods[iv])})
+			seriesData.Observations[strconv.Itoa(*timePeriod)] = []interface{}{
+				value,
+				obsStatuses.add(&sdmxJSONItem{ID: iv.ObsStatus}),
+				comments.add(&sdmxJSONItem{Value: iv.Footnote}),
+			}
+		}
+		dataSet.Series[seriesKey] = seriesData
+	}
+
+	keyPositions := []int{0, 1, 2, 3}
+	message := &sdmxJSONMessage{
+		Meta: sdmxJSONMeta{
+			Schema:           sdmxJSONSchema,
+			ID:               params.ID,
+			Prepared:         params.Prepared.Format(time.RFC3339),
+			ContentLanguages: []string{params.Language},
+			Sender:           sdmxJSONItem{ID: params.AgencyID},
+		},
+		Data: sdmxJSONData{
+			Structures: []*sdmxJSONStructure{
+				{
+					Structure: params.structureURN(),
+					Dimensions: sdmxJSONComponents{
+						DataSet: []*sdmxJSONComponent{},
+						Series: []*sdmxJSONComponent{
+							{ID: SDMXDimensionFrequency, Name: "Frequency", KeyPosition: &keyPositions[0], Values: frequencies.values},
+							{ID: SDMXDimensionRefArea, Name: "Reference area", KeyPosition: &keyPositions[1], Values: countries.values},
+							{ID: SDMXDimensionIndicator, Name: "Indicator", KeyPosition: &keyPositions[2], Values: indicators.values},
+						},
+						Observation: []*sdmxJSONComponent{
+							{
+								ID:          SDMXDimensionTimePeriod,
+								Name:        "Time period",
+								KeyPosition: &keyPositions[3],
+								Roles:       []string{SDMXDimensionTimePeriod},
+								Values:      timePeriods.values,
+							},
+						},
+					},
+					Attributes: sdmxJSONComponents{
+						DataSet: []*sdmxJSONComponent{},
+						Series: []*sdmxJSONComponent{
+							{ID: SDMXAttributeSource, Name: "Source", Values: sources.values},
+							{ID: SDMXAttributeUnit, Name: "Unit of measure", Values: units.values},
+							{ID: SDMXAttributeDecimals, Name: "Decimals", Values: decimals.values},
+						},
+						Observation: []*sdmxJSONComponent{
+							{ID: SDMXAttributeObsStatus, Name: "Observation status", Values: obsStatuses.values},
+							{ID: SDMXAttributeComment, Name: "Comment", Values: comments.values},
+						},
+					},
+					Measures: sdmxJSONMeasures{
+						Observation: []*sdmxJSONComponent{
+							{ID: SDMXMeasureObsValue, Name: "Observation value"},
+						},
+					},
+				},
+			},
+			DataSets: []*sdmxJSONDataSet{dataSet},
+		},
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(message); err != nil {
+		return fmt.Errorf("failed to encode SDMX-JSON: %v", err)
+	}
+
+	return nil
+}
+
+// sdmxCodeName returns the name of the code in the codelist, or fallback if the code is missing
+func sdmxCodeName(cl *SDMXCodelist, id, fallback string) string {
+	if name := cl.CodeName(id); name != "" {
+		return name
+	}
+
+	return fallback
+}
+
+type (
+	sdmxMLGenericData struct {
+		XMLName      xml.Name      `xml:"message:GenericData"`
+		XMLNSMessage string        `xml:"xmlns:message,attr"`
+		XMLNSGeneric string        `xml:"xmlns:generic,attr"`
+		XMLNSCommon  string        `xml:"xmlns:common,attr"`
+		Header       sdmxMLHeader  `xml:"message:Header"`
+		DataSet      sdmxMLDataSet `xml:"message:DataSet"`
+	}
+
+	sdmxMLHeader struct {
+		ID        string           `xml:"message:ID"`
+		Test      bool             `xml:"message:Test"`
+		Prepared  string           `xml:"message:Prepared"`
+		Sender    sdmxMLSender     `xml:"message:Sender"`
+		Structure *sdmxMLStructure `xml:"message:Structure,omitempty"`
+	}
+
+	sdmxMLSender struct {
+		ID string `xml:"id,attr"`
+	}
+
+	sdmxMLStructure struct {
+		StructureID            string      `xml:"structureID,attr"`
+		DimensionAtObservation string      `xml:"dimensionAtObservation,attr"`
+		Structure              sdmxMLRefOf `xml:"common:Structure"`
+	}
+
+	sdmxMLRefOf struct {
+		Ref sdmxMLRef `xml:"Ref"`
+	}
+
+	sdmxMLRef struct {
+		AgencyID string `xml:"agencyID,attr"`
+		ID       string `xml:"id,attr"`
+		Version  string `xml:"version,attr"`
+	}
+
+	sdmxMLDataSet struct {
+		StructureRef string          `xml:"structureRef,attr"`
+		Series       []*sdmxMLSeries `xml:"generic:Series"`
+	}
+
+	sdmxMLSeries struct {
+		SeriesKey  sdmxMLValues  `xml:"generic:SeriesKey"`
+		Attributes *sdmxMLValues `xml:"generic:Attributes,omitempty"`
+		Obs        []*sdmxMLObs  `xml:"generic:Obs"`
+	}
+
+	sdmxMLValues struct {
+		Values []*sdmxMLValue `xml:"generic:Value"`
+	}
+
+	sdmxMLValue struct {
+		ID    string `xml:"id,attr,omitempty"`
+		Value string `xml:"value,attr"`
+	}
+
+	sdmxMLObs struct {
+		ObsDimension sdmxMLValue   `xml:"generic:ObsDimension"`
+		ObsValue     *sdmxMLValue  `xml:"generic:ObsValue,omitempty"`
+		Attributes   *sdmxMLValues `xml:"generic:Attributes,omitempty"`
+	}
+)
+
+// newSDMXMLValues returns values which are not empty, or nil if all values are empty
+func newSDMXMLValues(values ...*sdmxMLValue) *sdmxMLValues {
+	vs := &sdmxMLValues{}
+	for _, v := range values {
+		if v.Value != "" {
+			vs.Values = append(vs.Values, v)
+		}
+	}
+	if len(vs.Values) == 0 {
+		return nil
+	}
+
+	return vs
+}
+
+// WriteSDMXML writes indicator values with footnote as an SDMX-ML 2.1 generic data message.
+// Dimensions and attributes are mapped in the same way as WriteSDMXJSON.
+// The codelists can be written with WriteSDMXMLCodelists.
+func WriteSDMXML(
+	w io.Writer,
+	indicatorValues []*IndicatorValueWithFootnote,
+	codelists *SDMXCodelists,
+	params *SDMXParams,
+) error {
+	params = params.withDefaults()
+
+	series, periods, err := groupSDMXSeries(indicatorValues, codelists)
+	if err != nil {
+		return err
+	}
+
+	message := &sdmxMLGenericData{
+		XMLNSMessage: sdmxMLNamespace + "message",
+		XMLNSGeneric: sdmxMLNamespace + "data/generic",
+		XMLNSCommon:  sdmxMLNamespace + "common",
+		Header:       params.sdmxMLHeader(),
+		DataSet: sdmxMLDataSet{
+			StructureRef: params.StructureID,
+		},
+	}
+	message.Header.Structure = &sdmxMLStructure{
+		StructureID:            params.StructureID,
+		DimensionAtObservation: SDMXDimensionTimePeriod,
+		Structure: sdmxMLRefOf{
+			Ref: sdmxMLRef{
+				AgencyID: params.AgencyID,
+				ID:       params.StructureID,
+				Version:  params.StructureVersion,
+			},
+		},
+	}
+
+	for _, s := range series {
+		mlSeries := &sdmxMLSeries{
+			SeriesKey: sdmxMLValues{
+				Values: []*sdmxMLValue{
+					{ID: SDMXDimensionFrequency, Value: s.key[0]},
+					{ID: SDMXDimensionRefArea, Value: s.key[1]},
+					{ID: SDMXDimensionIndicator, Value: s.key[2]},
+				},
+			},
+			Attributes: newSDMXMLValues(
+				&sdmxMLValue{ID: SDMXAttributeSource, Value: s.source},
+				&sdmxMLValue{ID: SDMXAttributeUnit, Value: s.unit},
+				&sdmxMLValue{ID: SDMXAttributeDecimals, Value: s.decimals},
+			),
+		}
+		for _, iv := range s.observations {
+			obs := &sdmxMLObs{
+				ObsDimension: sdmxMLValue{Value: sdmxTimePeriod(periods[iv])},
+				Attributes: newSDMXMLValues(
+					&sdmxMLValue{ID: SDMXAttributeObsStatus, Value: iv.ObsStatus},
+					&sdmxMLValue{ID: SDMXAttributeComment, Value: iv.Footnote},
+				),
+			}
+			if !iv.IsNull() {
+				obs.ObsValue = &sdmxMLValue{Value: strconv.FormatFloat(iv.Value, 'f', -1, 64)}
+			}
+			mlSeries.Obs = append(mlSeries.Obs, obs)
+		}
+		message.DataSet.Series = append(message.DataSet.Series, mlSeries)
+	}
+
+	return writeSDMXML(w, message)
+}
+
+func (params *SDMXParams) sdmxMLHeader() sdmxMLHeader {
+	return sdmxMLHeader{
+		ID:       params.ID,
+		Prepared: params.Prepared.Format(time.RFC3339),
+		Sender:   sdmxMLSender{ID: params.AgencyID},
+	}
+}
+
+type (
+	sdmxMLStructureMessage struct {
+		XMLName        xml.Name          `xml:"message:Structure"`
+		XMLNSMessage   string            `xml:"xmlns:message,attr"`
+		XMLNSStructure string            `xml:"xmlns:structure,attr"`
+		XMLNSCommon    string            `xml:"xmlns:common,attr"`
+		Header         sdmxMLHeader      `xml:"message:Header"`
+		Codelists      []*sdmxMLCodelist `xml:"message:Structures>structure:Codelists>structure:Codelist"`
+	}
+
+	sdmxMLCodelist struct {
+		ID       string        `xml:"id,attr"`
+		AgencyID string        `xml:"agencyID,attr"`
+		Version  string        `xml:"version,attr"`
+		Name     sdmxMLName    `xml:"common:Name"`
+		Codes    []*sdmxMLCode `xml:"structure:Code"`
+	}
+
+	sdmxMLCode struct {
+		ID   string     `xml:"id,attr"`
+		Name sdmxMLName `xml:"common:Name"`
+	}
+
+	sdmxMLName struct {
+		Lang  string `xml:"xml:lang,attr"`
+		Value string `xml:",chardata"`
+	}
+)
+
+// WriteSDMXMLCodelists writes codelists as an SDMX-ML 2.1 structure message
+func WriteSDMXMLCodelists(w io.Writer, codelists *SDMXCodelists, params *SDMXParams) error {
+	if codelists == nil {
+		return errors.New("codelists is required")
+	}
+	params = params.withDefaults()
+
+	message := &sdmxMLStructureMessage{
+		XMLNSMessage:   sdmxMLNamespace + "message",
+		XMLNSStructure: sdmxMLNamespace + "structure",
+		XMLNSCommon:    sdmxMLNamespace + "common",
+		Header:         params.sdmxMLHeader(),
+	}
+	for _, cl := range []*SDMXCodelist{codelists.Frequencies, codelists.Countries, codelists.Indicators, codelists.Sources} {
+		if cl == nil {
+			continue
+		}
+		mlCodelist := &sdmxMLCodelist{
+			ID:       cl.ID,
+			AgencyID: params.AgencyID,
+			Version:  params.StructureVersion,
+			Name:     sdmxMLName{Lang: params.Language, Value: cl.Name},
+		}
+		for _, code := range cl.Codes {
+			mlCodelist.Codes = append(mlCodelist.Codes, &sdmxMLCode{
+				ID:   code.ID,
+				Name: sdmxMLName{Lang: params.Language, Value: code.Name},
+			})
+		}
+		message.Codelists = append(message.Codelists, mlCodelist)
+	}
+
+	return writeSDMXML(w, message)
+}
+
+func writeSDMXML(w io.Writer, message interface{}) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(message); err != nil {
+		return fmt.Errorf("failed to encode SDMX-ML: %v", err)
+	}
+
+	return nil
+}
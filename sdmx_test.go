@@ -0,0 +1,153 @@
+package wbdata
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestSDMXData() ([]*IndicatorValueWithFootnote, *SDMXCodelists, *SDMXParams) {
+	indicatorValues := []*IndicatorValueWithFootnote{}
+	for _, iv := range newTestIndicatorValues() {
+		indicatorValues = append(indicatorValues, &IndicatorValueWithFootnote{IndicatorValue: *iv})
+	}
+	indicatorValues[1].ObsStatus = "E"
+	indicatorValues[1].Footnote = "Estimated"
+
+	codelists := NewSDMXCodelists(
+		[]*Country{{ID: "JPN", Name: "Japan"}},
+		[]*Indicator{{ID: "NY.GDP.MKTP.CD", Name: "GDP (current US$)", Source: &IDAndValue{ID: "2"}}},
+		[]*Source{{ID: "2", Name: "World Development Indicators"}},
+	)
+	params := &SDMXParams{
+		ID:       "TEST",
+		Prepared: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	return indicatorValues, codelists, params
+}
+
+func TestWriteSDMXJSON(t *testing.T) {
+	indicatorValues, codelists, params := newTestSDMXData()
+
+	var buf bytes.Buffer
+	if err := WriteSDMXJSON(&buf, indicatorValues, codelists, params); err != nil {
+		t.Fatalf("WriteSDMXJSON() error = %v", err)
+	}
+
+	got := &sdmxJSONMessage{}
+	if err := json.Unmarshal(buf.Bytes(), got); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	if got.Meta.ID != "TEST" || got.Meta.Prepared != "2021-06-01T00:00:00Z" {
+		t.Errorf("WriteSDMXJSON() meta = %+v", got.Meta)
+	}
+
+	structure := got.Data.Structures[0]
+	if structure.Structure != "urn:sdmx:org.sdmx.infomodel.datastructure.DataStructure=WB:WDI(1.0)" {
+		t.Errorf("WriteSDMXJSON() structure = %v", structure.Structure)
+	}
+	wantSeriesDimensions := map[string][]*sdmxJSONItem{
+		SDMXDimensionFrequency: {{ID: "A", Name: "Annual"}},
+		SDMXDimensionRefArea:   {{ID: "JPN", Name: "Japan"}},
+		SDMXDimensionIndicator: {{ID: "NY.GDP.MKTP.CD", Name: "GDP (current US$)"}},
+	}
+	for _, dimension := range structure.Dimensions.Series {
+		if !reflect.DeepEqual(dimension.Values, wantSeriesDimensions[dimension.ID]) {
+			t.Errorf("WriteSDMXJSON() dimension %s = %v, want %v", dimension.ID, dimension.Values, wantSeriesDimensions[dimension.ID])
+		}
+	}
+	wantTimePeriods := []*sdmxJSONItem{{Value: "2019"}, {Value: "2020"}}
+	if !reflect.DeepEqual(structure.Dimensions.Observation[0].Values, wantTimePeriods) {
+		t.Errorf("WriteSDMXJSON() time periods = %v, want %v", structure.Dimensions.Observation[0].Values, wantTimePeriods)
+	}
+
+	series, ok := got.Data.DataSets[0].Series["0:0:0"]
+	if !ok {
+		t.Fatalf("WriteSDMXJSON() series = %v, want key 0:0:0", got.Data.DataSets[0].Series)
+	}
+	wantObservations := map[string][]interface{}{
+		"0": {5.08176954237977e+12, float64(0), float64(0)},
+		"1": {nil, nil, nil},
+	}
+	if !reflect.DeepEqual(series.Observations, wantObservations) {
+		t.Errorf("WriteSDMXJSON() observations = %v, want %v", series.Observations, wantObservations)
+	}
+	if source := series.Attributes[0]; source == nil || *source != 0 {
+		t.Errorf("WriteSDMXJSON() source attribute = %v, want 0", source)
+	}
+}
+
+func TestWriteSDMXML(t *testing.T) {
+	indicatorValues, codelists, params := newTestSDMXData()
+
+	var buf bytes.Buffer
+	if err := WriteSDMXML(&buf, indicatorValues, codelists, params); err != nil {
+		t.Fatalf("WriteSDMXML() error = %v", err)
+	}
+	got := buf.String()
+
+	for _, want := range []string{
+		`<message:GenericData xmlns:message="http://www.sdmx.org/resources/sdmxml/schemas/v2_1/message"`,
+		`<message:Structure structureID="WDI" dimensionAtObservation="TIME_PERIOD">`,
+		`<Ref agencyID="WB" id="WDI" version="1.0"></Ref>`,
+		`<generic:Value id="REF_AREA" value="JPN"></generic:Value>`,
+		`<generic:Value id="SOURCE" value="2"></generic:Value>`,
+		`<generic:ObsDimension value="2019"></generic:ObsDimension>`,
+		`<generic:ObsValue value="5081769542379.77"></generic:ObsValue>`,
+		`<generic:Value id="OBS_STATUS" value="E"></generic:Value>`,
+		`<generic:Value id="COMMENT_OBS" value="Estimated"></generic:Value>`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("WriteSDMXML() = %v, want to contain %v", got, want)
+		}
+	}
+	if strings.Count(got, "<generic:ObsValue") != 1 {
+		t.Errorf("WriteSDMXML() should not write ObsValue for null values")
+	}
+	if err := xml.Unmarshal(buf.Bytes(), new(interface{})); err != nil {
+		t.Errorf("WriteSDMXML() is not well-formed: %v", err)
+	}
+}
+
+func TestWriteSDMX_withoutCountryiso3code(t *testing.T) {
+	indicatorValues, codelists, params := newTestSDMXData()
+	// REF_AREA should not fall back to the ISO2 code of the country
+	indicatorValues[0].Countryiso3code = ""
+
+	if err := WriteSDMXJSON(&bytes.Buffer{}, indicatorValues, codelists, params); err == nil {
+		t.Errorf("WriteSDMXJSON() error = nil, want error")
+	}
+	if err := WriteSDMXML(&bytes.Buffer{}, indicatorValues, codelists, params); err == nil {
+		t.Errorf("WriteSDMXML() error = nil, want error")
+	}
+}
+
+func TestWriteSDMXMLCodelists(t *testing.T) {
+	_, codelists, params := newTestSDMXData()
+
+	var buf bytes.Buffer
+	if err := WriteSDMXMLCodelists(&buf, codelists, params); err != nil {
+		t.Fatalf("WriteSDMXMLCodelists() error = %v", err)
+	}
+	got := buf.String()
+
+	for _, want := range []string{
+		`<structure:Codelist id="CL_REF_AREA" agencyID="WB" version="1.0">`,
+		`<structure:Code id="JPN">`,
+		`<common:Name xml:lang="en">World Development Indicators</common:Name>`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("WriteSDMXMLCodelists() = %v, want to contain %v", got, want)
+		}
+	}
+
+	if err := WriteSDMXMLCodelists(&buf, nil, params); err == nil {
+		t.Errorf("WriteSDMXMLCodelists() error = nil, want error with nil codelists")
+	}
+}
@@ -0,0 +1,306 @@
+package wbdata
+
+import (
+	"encoding/gob"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	indicatorIndexVersion = 1
+
+	searchWeightID                 = 5
+	searchWeightName               = 4
+	searchWeightTopic              = 2
+	searchWeightSourceOrganization = 1
+	searchWeightSourceNote         = 1
+)
+
+type (
+	// IndicatorIndex is an in-memory full-text index of indicators
+	IndicatorIndex struct {
+		indicators []*Indicator
+		// postings are the weighted term frequencies of indicators by terms
+		postings map[string][]indicatorPosting
+		// idOrder is the indexes of indicators ordered by lowercase IDs for prefix search
+		idOrder []int
+	}
+
+	// IndicatorSearchParams contains parameters for IndicatorIndex.Search and IndicatorIndex.SearchIDPrefix
+	IndicatorSearchParams struct {
+		// TopicIDs limits results to indicators which have one of the topics
+		TopicIDs []string
+		// SourceIDs limits results to indicators of one of the sources
+		SourceIDs []string
+		// Limit is the maximum number of results. If it is 0, all results are returned.
+		Limit int
+	}
+
+	// IndicatorSearchResult is an indicator found by IndicatorIndex.Search
+	IndicatorSearchResult struct {
+		Indicator *Indicator
+		Score     float64
+	}
+
+	indicatorPosting struct {
+		Doc    int
+		Weight float64
+	}
+
+	// indicatorIndexData is the serialized form of IndicatorIndex
+	indicatorIndexData struct {
+		Version    int
+		Indicators []*Indicator
+		Postings   map[string][]indicatorPosting
+		IDOrder    []int
+	}
+)
+
+// BuildIndex returns an IndicatorIndex of all indicators, requesting perPage indicators at a time
+func (i *IndicatorsService) BuildIndex(perPage int) (*IndicatorIndex, error) {
+	indicators := []*Indicator{}
+	it := i.Iterate(perPage)
+	for it.Next() {
+		indicators = append(indicators, it.Indicator())
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	return NewIndicatorIndex(indicators), nil
+}
+
+// NewIndicatorIndex returns an IndicatorIndex of indicators.
+// It indexes ID, name, source note, source organization and topics.
+func NewIndicatorIndex(indicators []*Indicator) *IndicatorIndex {
+	idx := &IndicatorIndex{
+		indicators: indicators,
+		postings:   map[string][]indicatorPosting{},
+		idOrder:    make([]int, len(indicators)),
+	}
+
+	for doc, ind := range indicators {
+		weights := map[string]float64{}
+		addTerms := func(text string, weight float64) {
+			for _, term := range searchTerms(text) {
+				weights[term] += weight
+			}
+		}
+		addTerms(ind.ID, searchWeightID)
+		addTerms(ind.Name, searchWeightName)
+		addTerms(ind.SourceNote, searchWeightSourceNote)
+		addTerms(ind.SourceOrganization, searchWeightSourceOrganization)
+		for _, t := range ind.Topics {
+			if t != nil {
+				addTerms(t.Value, searchWeightTopic)
+			}
+		}
+
+		for term, weight := range weights {
+			idx.postings[term] = append(idx.postings[term], indicatorPosting{Doc: doc, Weight: weight})
+		}
+		idx.idOrder[doc] = doc
+	}
+
+	sort.SliceStable(idx.idOrder, func(a, b int) bool {
+		return strings.ToLower(indicators[idx.idOrder[a]].ID) < strings.ToLower(indicators[idx.idOrder[b]].ID)
+	})
+
+	return idx
+}
+
+// LoadIndicatorIndex reads an IndicatorIndex written by IndicatorIndex.Save
+func LoadIndicatorIndex(r io.Reader) (*IndicatorIndex, error) {
+	data := &indicatorIndexData{}
+	if err := gob.NewDecoder(r).Decode(data); err != nil {
+		return nil, fmt.Errorf("failed to decode indicator index: %v", err)
+	}
+	if err := data.validate(); err != nil {
+		return nil, err
+	}
+
+	idx := &IndicatorIndex{
+		indicators: data.Indicators,
+		postings:   data.Postings,
+		idOrder:    data.IDOrder,
+	}
+	if idx.postings == nil {
+		idx.postings = map[string][]indicatorPosting{}
+	}
+
+	return idx, nil
+}
+
+// validate returns an error if the data is of another version or refers to indicators out of range
+func (data *indicatorIndexData) validate() error {
+	if data.Version != indicatorIndexVersion {
+		return fmt.Errorf("unsupported indicator index version: %d", data.Version)
+	}
+	if len(data.IDOrder) != len(data.Indicators) {
+		return fmt.Errorf("broken indicator index: %d ids for %d indicators", len(data.IDOrder), len(data.Indicators))
+	}
+	for i, ind := range data.Indicators {
+		if ind == nil {
+			return fmt.Errorf("broken indicator index: indicator %d is nil", i)
+		}
+	}
+	for _, doc := range data.IDOrder {
+		if doc < 0 || doc >= len(data.Indicators) {
+			return fmt.Errorf("broken indicator index: id order %d is out of %d indicators", doc, len(data.Indicators))
+		}
+	}
+	for term, postings := range data.Postings {
+		for _, p := range postings {
+			if p.Doc < 0 || p.Doc >= len(data.Indicators) {
+				return fmt.Errorf("broken indicator index: posting %d of %q is out of %d indicators", p.Doc, term, len(data.Indicators))
+			}
+		}
+	}
+
+	return nil
+}
+
+// Save writes the index to w, which can be read by LoadIndicatorIndex
+func (idx *IndicatorIndex) Save(w io.Writer) error {
+	data := &indicatorIndexData{
+		Version:    indicatorIndexVersion,
+		Indicators: idx.indicators,
+		Postings:   idx.postings,
+		IDOrder:    idx.idOrder,
+	}
+	if err := gob.NewEncoder(w).Encode(data); err != nil {
+		return fmt.Errorf("failed to encode indicator index: %v", err)
+	}
+
+	return nil
+}
+
+// Len returns the number of indexed indicators
+func (idx *IndicatorIndex) Len() int {
+	return len(idx.indicators)
+}
+
+// Search returns indicators which contain all keywords of query, ordered by relevance.
+// Keywords are case insensitive and ranked by TF-IDF with field weights, e.g. a match in a name ranks higher than in a source note.
+func (idx *IndicatorIndex) Search(query string, params *IndicatorSearchParams) []*IndicatorSearchResult {
+	terms := searchTerms(query)
+	if len(terms) == 0 {
+		return []*IndicatorSearchResult{}
+	}
+
+	scores := map[int]float64{}
+	for i, term := range uniqueStrings(terms) {
+		postings := idx.postings[term]
+		if len(postings) == 0 {
+			return []*IndicatorSearchResult{}
+		}
+
+		idf := math.Log(1 + float64(len(idx.indicators))/float64(len(postings)))
+		matched := map[int]float64{}
+		for _, p := range postings {
+			if _, ok := scores[p.Doc]; i == 0 || ok {
+				matched[p.Doc] = scores[p.Doc] + p.Weight*idf
+			}
+		}
+		scores = matched
+	}
+
+	results := []*IndicatorSearchResult{}
+	for doc, score := range scores {
+		ind := idx.indicators[doc]
+		if !params.match(ind) {
+			continue
+		}
+		results = append(results, &IndicatorSearchResult{Indicator: ind, Score: score})
+	}
+	sort.Slice(results, func(a, b int) bool {
+		if results[a].Score != results[b].Score {
+			return results[a].Score > results[b].Score
+		}
+		return results[a].Indicator.ID < results[b].Indicator.ID
+	})
+
+	if params != nil && params.Limit > 0 && len(results) > params.Limit {
+		results = results[:params.Limit]
+	}
+
+	return results
+}
+
+// SearchIDPrefix returns indicators whose IDs start with prefix, ordered by ID. The prefix is case insensitive.
+func (idx *IndicatorIndex) SearchIDPrefix(prefix string, params *IndicatorSearchParams) []*Indicator {
+	prefix = strings.ToLower(prefix)
+	start := sort.Search(len(idx.idOrder), func(i int) bool {
+		return strings.ToLower(idx.indicators[idx.idOrder[i]].ID) >= prefix
+	})
+
+	results := []*Indicator{}
+	for _, doc := range idx.idOrder[start:] {
+		ind := idx.indicators[doc]
+		if !strings.HasPrefix(strings.ToLower(ind.ID), prefix) {
+			break
+		}
+		if !params.match(ind) {
+			continue
+		}
+		results = append(results, ind)
+		if params != nil && params.Limit > 0 && len(results) == params.Limit {
+			break
+		}
+	}
+
+	return results
+}
+
+func (params *IndicatorSearchParams) match(ind *Indicator) bool {
+	if params == nil {
+		return true
+	}
+	if len(params.SourceIDs) != 0 && !containsString(params.SourceIDs, ind.SourceID()) {
+		return false
+	}
+	if len(params.TopicIDs) != 0 {
+		for _, id := range ind.TopicIDs() {
+			if containsString(params.TopicIDs, id) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return true
+}
+
+// searchTerms splits text into lowercase words of letters and digits
+func searchTerms(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+func uniqueStrings(ss []string) []string {
+	seen := map[string]bool{}
+	unique := []string{}
+	for _, s := range ss {
+		if !seen[s] {
+			seen[s] = true
+			unique = append(unique, s)
+		}
+	}
+
+	return unique
+}
+
+func containsString(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
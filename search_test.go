@@ -0,0 +1,262 @@
+package wbdata
+
+import (
+	"bytes"
+	"encoding/gob"
+	"reflect"
+	"testing"
+
+	"github.com/jkkitakita/wbdata-go/testutils"
+)
+
+func newTestIndicatorIndex() *IndicatorIndex {
+	wdi := &IDAndValue{ID: "2", Value: "World Development Indicators"}
+	adi := &IDAndValue{ID: "11", Value: "Africa Development Indicators"}
+	economy := &IDAndValue{ID: "3", Value: "Economy & Growth"}
+
+	return NewIndicatorIndex([]*Indicator{
+		{
+			ID:                 "NY.GDP.MKTP.CD",
+			Name:               "GDP (current US$)",
+			Source:             wdi,
+			SourceNote:         "GDP at purchaser's prices is the sum of gross value added by all resident producers.",
+			SourceOrganization: "World Bank national accounts data.",
+			Topics:             []*IDAndValue{economy},
+		},
+		{
+			ID:                 "NY.GDP.PCAP.CD",
+			Name:               "GDP per capita (current US$)",
+			Source:             wdi,
+			SourceNote:         "GDP per capita is gross domestic product divided by midyear population.",
+			SourceOrganization: "World Bank national accounts data.",
+			Topics:             []*IDAndValue{economy},
+		},
+		{
+			ID:                 "SP.POP.TOTL",
+			Name:               "Population, total",
+			Source:             wdi,
+			SourceNote:         "Total population counts all residents regardless of legal status or citizenship.",
+			SourceOrganization: "United Nations Population Division.",
+			Topics:             []*IDAndValue{{ID: "8", Value: "Health "}},
+		},
+		{
+			ID:         "NY.GDP.MKTP.KD",
+			Name:       "GDP (constant 2010 US$)",
+			Source:     adi,
+			SourceNote: "Data are in constant 2010 U.S. dollars.",
+			Topics:     []*IDAndValue{{}},
+		},
+	})
+}
+
+func searchResultIDs(results []*IndicatorSearchResult) []string {
+	ids := []string{}
+	for _, r := range results {
+		ids = append(ids, r.Indicator.ID)
+	}
+
+	return ids
+}
+
+func TestIndicatorIndex_Search(t *testing.T) {
+	idx := newTestIndicatorIndex()
+
+	type args struct {
+		query  string
+		params *IndicatorSearchParams
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "ranked by field weights",
+			args: args{
+				query: "population",
+			},
+			want: []string{"SP.POP.TOTL", "NY.GDP.PCAP.CD"},
+		},
+		{
+			name: "all keywords are required",
+			args: args{
+				query: "GDP per capita",
+			},
+			want: []string{"NY.GDP.PCAP.CD"},
+		},
+		{
+			name: "id",
+			args: args{
+				query: "ny.gdp.mktp.cd",
+			},
+			want: []string{"NY.GDP.MKTP.CD"},
+		},
+		{
+			name: "filter by source",
+			args: args{
+				query:  "gdp",
+				params: &IndicatorSearchParams{SourceIDs: []string{"11"}},
+			},
+			want: []string{"NY.GDP.MKTP.KD"},
+		},
+		{
+			name: "filter by topic with limit",
+			args: args{
+				query:  "gdp",
+				params: &IndicatorSearchParams{TopicIDs: []string{"3"}, Limit: 1},
+			},
+			want: []string{"NY.GDP.MKTP.CD"},
+		},
+		{
+			name: "not found",
+			args: args{
+				query: "gdp inflation",
+			},
+			want: []string{},
+		},
+		{
+			name: "empty query",
+			args: args{
+				query: " ",
+			},
+			want: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := searchResultIDs(idx.Search(tt.args.query, tt.args.params))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("IndicatorIndex.Search() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIndicatorIndex_SearchIDPrefix(t *testing.T) {
+	idx := newTestIndicatorIndex()
+
+	tests := []struct {
+		name   string
+		prefix string
+		params *IndicatorSearchParams
+		want   []string
+	}{
+		{
+			name:   "success",
+			prefix: "ny.gdp.",
+			want:   []string{"NY.GDP.MKTP.CD", "NY.GDP.MKTP.KD", "NY.GDP.PCAP.CD"},
+		},
+		{
+			name:   "filter by source",
+			prefix: "NY.GDP.MKTP",
+			params: &IndicatorSearchParams{SourceIDs: []string{testutils.TestDefaultSourceID}},
+			want:   []string{"NY.GDP.MKTP.CD"},
+		},
+		{
+			name:   "not found",
+			prefix: "XX",
+			want:   []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []string{}
+			for _, ind := range idx.SearchIDPrefix(tt.prefix, tt.params) {
+				got = append(got, ind.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("IndicatorIndex.SearchIDPrefix() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIndicatorIndex_Save(t *testing.T) {
+	idx := newTestIndicatorIndex()
+
+	buf := &bytes.Buffer{}
+	if err := idx.Save(buf); err != nil {
+		t.Fatalf("IndicatorIndex.Save() error = %v", err)
+	}
+	got, err := LoadIndicatorIndex(buf)
+	if err != nil {
+		t.Fatalf("LoadIndicatorIndex() error = %v", err)
+	}
+
+	if got.Len() != idx.Len() {
+		t.Errorf("LoadIndicatorIndex() Len() = %d, want %d", got.Len(), idx.Len())
+	}
+	if !reflect.DeepEqual(got.Search("population", nil), idx.Search("population", nil)) {
+		t.Errorf("LoadIndicatorIndex() Search() = %v, want %v", got.Search("population", nil), idx.Search("population", nil))
+	}
+
+	if _, err := LoadIndicatorIndex(bytes.NewBufferString("invalid")); err == nil {
+		t.Errorf("LoadIndicatorIndex() error = nil, want error")
+	}
+}
+
+func TestLoadIndicatorIndex_broken(t *testing.T) {
+	indicators := newTestIndicatorIndex().indicators
+	tests := []struct {
+		name string
+		data *indicatorIndexData
+	}{
+		{
+			name: "failure because of an unsupported version",
+			data: &indicatorIndexData{Version: indicatorIndexVersion + 1},
+		},
+		{
+			name: "failure because of a truncated id order",
+			data: &indicatorIndexData{Version: indicatorIndexVersion, Indicators: indicators, IDOrder: []int{0}},
+		},
+		{
+			name: "failure because of an id order out of range",
+			data: &indicatorIndexData{
+				Version:    indicatorIndexVersion,
+				Indicators: indicators[:2],
+				IDOrder:    []int{0, 2},
+			},
+		},
+		{
+			name: "failure because of a posting out of range",
+			data: &indicatorIndexData{
+				Version:    indicatorIndexVersion,
+				Indicators: indicators[:2],
+				Postings:   map[string][]indicatorPosting{"population": {{Doc: 0, Weight: 1}, {Doc: 5, Weight: 1}}},
+				IDOrder:    []int{0, 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			if err := gob.NewEncoder(buf).Encode(tt.data); err != nil {
+				t.Fatalf("gob.Encode() error = %v", err)
+			}
+			if _, err := LoadIndicatorIndex(buf); err == nil {
+				t.Errorf("LoadIndicatorIndex() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestIndicatorsService_BuildIndex(t *testing.T) {
+	client, save := NewTestClient(t, *update)
+	defer save()
+
+	i := &IndicatorsService{
+		client: client,
+	}
+	got, err := i.BuildIndex(testutils.TestDefaultPerPage)
+	if err != nil {
+		t.Fatalf("IndicatorsService.BuildIndex() error = %v", err)
+	}
+	if got.Len() != 3 {
+		t.Errorf("IndicatorsService.BuildIndex() Len() = %d, want %d", got.Len(), 3)
+	}
+
+	want := []string{"SP.POP.TOTL", "NY.GDP.PCAP.CD"}
+	if ids := searchResultIDs(got.Search("population", nil)); !reflect.DeepEqual(ids, want) {
+		t.Errorf("IndicatorIndex.Search() = %v, want %v", ids, want)
+	}
+}
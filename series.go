@@ -0,0 +1,257 @@
+package wbdata
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+type (
+	// SeriesKey identifies a Series by an indicator and a country
+	SeriesKey struct {
+		IndicatorID string
+		// CountryID is the ISO3 code of the country, or the country ID if the ISO3 code is empty, e.g. for some aggregates
+		CountryID string
+	}
+
+	// Series is the values of an indicator in a country ordered by period, oldest first.
+	// Values should not be reordered because the periods of them are kept in the same order.
+	Series struct {
+		Key       SeriesKey
+		Indicator IDAndValue
+		Country   IDAndValue
+		Frequency FrequencyType
+		Values    []*IndicatorValue
+		// Base is the base period of a series rebased or deflated by Series.Rebase or Series.Deflate, e.g. 2015
+		Base string
+		// DeflatorID is the indicator ID of the price index which a series is deflated by, e.g. NY.GDP.DEFL.ZS
+		DeflatorID string
+
+		periods []period
+	}
+)
+
+// String returns the key such as NY.GDP.MKTP.CD/JPN
+func (k SeriesKey) String() string {
+	return k.IndicatorID + "/" + k.CountryID
+}
+
+// GroupSeries groups indicator values, e.g. the result of IndicatorValuesService.List, by indicator and country
+func GroupSeries(indicatorValues []*IndicatorValue) (map[SeriesKey]*Series, error) {
+	grouped := map[SeriesKey][]*IndicatorValue{}
+	for _, iv := range indicatorValues {
+		key := seriesKeyOf(iv)
+		grouped[key] = append(grouped[key], iv)
+	}
+
+	series := make(map[SeriesKey]*Series, len(grouped))
+	for key, values := range grouped {
+		s, err := NewSeries(values)
+		if err != nil {
+			return nil, fmt.Errorf("failed to group %s: %v", key, err)
+		}
+		series[key] = s
+	}
+
+	return series, nil
+}
+
+// SeriesKeys returns the keys of series ordered by indicator ID and country ID
+func SeriesKeys(series map[SeriesKey]*Series) []SeriesKey {
+	keys := make([]SeriesKey, 0, len(series))
+	for key := range series {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].IndicatorID != keys[j].IndicatorID {
+			return keys[i].IndicatorID < keys[j].IndicatorID
+		}
+		return keys[i].CountryID < keys[j].CountryID
+	})
+
+	return keys
+}
+
+// NewSeries returns a Series of indicator values of the same indicator, country and frequency.
+// The values are sorted by period and a period should not appear twice.
+func NewSeries(indicatorValues []*IndicatorValue) (*Series, error) {
+	if len(indicatorValues) == 0 {
+		return nil, errors.New("indicator values should not be empty")
+	}
+
+	first := indicatorValues[0]
+	s := &Series{
+		Key:       seriesKeyOf(first),
+		Indicator: first.Indicator,
+		Country:   first.Country,
+		Values:    append([]*IndicatorValue{}, indicatorValues...),
+	}
+
+	periods := make(map[*IndicatorValue]period, len(indicatorValues))
+	for i, iv := range indicatorValues {
+		if key := seriesKeyOf(iv); key != s.Key {
+			return nil, fmt.Errorf("indicator values should be of %s, but got %s", s.Key, key)
+		}
+		p, err := parsePeriod(iv.Date)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			s.Frequency = p.frequency
+		}
+		if p.frequency != s.Frequency {
+			return nil, mixedFrequencyError(iv.Date)
+		}
+		periods[iv] = p
+	}
+
+	sort.SliceStable(s.Values, func(i, j int) bool {
+		return periods[s.Values[i]].before(periods[s.Values[j]])
+	})
+	s.periods = make([]period, len(s.Values))
+	for i, iv := range s.Values {
+		s.periods[i] = periods[iv]
+		if i > 0 && s.periods[i] == s.periods[i-1] {
+			return nil, fmt.Errorf("date %s should not be duplicated in %s", iv.Date, s.Key)
+		}
+	}
+
+	return s, nil
+}
+
+// Len returns the number of values including nulls
+func (s *Series) Len() int {
+	return len(s.Values)
+}
+
+// Dates returns the dates of the values in order
+func (s *Series) Dates() []string {
+	dates := make([]string, len(s.Values))
+	for i, iv := range s.Values {
+		dates[i] = iv.Date
+	}
+
+	return dates
+}
+
+// At returns the value of the date, e.g. 2019, 2019Q1 or 2019M03.
+// It returns false if the series has no value of the date. A null value is returned with true.
+func (s *Series) At(date string) (*IndicatorValue, bool) {
+	p, err := parsePeriod(date)
+	if err != nil {
+		return nil, false
+	}
+	i := s.index(p)
+	if i < 0 {
+		return nil, false
+	}
+
+	return s.Values[i], true
+}
+
+// First returns the oldest non-null value. It returns nil if all values are null.
+func (s *Series) First() *IndicatorValue {
+	for _, iv := range s.Values {
+		if !iv.IsNull() {
+			return iv
+		}
+	}
+
+	return nil
+}
+
+// Last returns the latest non-null value. It returns nil if all values are null.
+func (s *Series) Last() *IndicatorValue {
+	for i := len(s.Values) - 1; i >= 0; i-- {
+		if !s.Values[i].IsNull() {
+			return s.Values[i]
+		}
+	}
+
+	return nil
+}
+
+// Slice returns a Series of the values from Start to End of dateRange inclusive. An empty Start or End is unbounded.
+// Dates of another frequency are compared by months, e.g. 2019 selects 2019Q1 to 2019Q4.
+func (s *Series) Slice(dateRange *DateRange) (*Series, error) {
+	sliced := s.withValues(nil, nil)
+	if len(s.Values) == 0 {
+		return sliced, nil
+	}
+
+	start, end, err := s.dateRangePeriods(dateRange)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, p := range s.periods {
+		if p.within(start, end) {
+			sliced.Values = append(sliced.Values, s.Values[i])
+			sliced.periods = append(sliced.periods, p)
+		}
+	}
+
+	return sliced, nil
+}
+
+// dateRangePeriods returns the periods of Start and End of dateRange.
+// An empty Start or End, or a nil dateRange, means the first or the last period of the series, which should not be empty.
+func (s *Series) dateRangePeriods(dateRange *DateRange) (period, period, error) {
+	start, end := s.periods[0], s.periods[len(s.periods)-1]
+	if dateRange == nil {
+		return start, end, nil
+	}
+
+	var err error
+	if dateRange.Start != "" {
+		if start, err = parsePeriod(dateRange.Start); err != nil {
+			return period{}, period{}, err
+		}
+	}
+	if dateRange.End != "" {
+		if end, err = parsePeriod(dateRange.End); err != nil {
+			return period{}, period{}, err
+		}
+	}
+
+	return start, end, nil
+}
+
+// checkFrequency returns an error if the periods are not of the frequency of the series
+func (s *Series) checkFrequency(start, end period) error {
+	if start.frequency != s.Frequency || end.frequency != s.Frequency {
+		return fmt.Errorf("dates should be of the frequency of the series, start: %s end: %s", start, end)
+	}
+
+	return nil
+}
+
+// index returns the index of the period, or -1 if the series has no value of the period
+func (s *Series) index(p period) int {
+	i := sort.Search(len(s.periods), func(i int) bool {
+		return !s.periods[i].before(p)
+	})
+	if i < len(s.periods) && s.periods[i] == p {
+		return i
+	}
+
+	return -1
+}
+
+// withValues returns a Series of the same key with values
+func (s *Series) withValues(values []*IndicatorValue, periods []period) *Series {
+	return &Series{
+		Key:        s.Key,
+		Indicator:  s.Indicator,
+		Country:    s.Country,
+		Frequency:  s.Frequency,
+		Values:     values,
+		Base:       s.Base,
+		DeflatorID: s.DeflatorID,
+		periods:    periods,
+	}
+}
+
+func seriesKeyOf(iv *IndicatorValue) SeriesKey {
+	return SeriesKey{IndicatorID: iv.Indicator.ID, CountryID: iv.countryCode()}
+}
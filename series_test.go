@@ -0,0 +1,143 @@
+package wbdata
+
+import (
+	"reflect"
+	"testing"
+)
+
+func newTestValue(indicatorID, countryID, date string, value float64) *IndicatorValue {
+	return &IndicatorValue{
+		Indicator:       IDAndValue{ID: indicatorID},
+		Country:         IDAndValue{ID: countryID},
+		Countryiso3code: countryID,
+		Date:            date,
+		Value:           value,
+	}
+}
+
+func newTestNullValue(indicatorID, countryID, date string) *IndicatorValue {
+	iv := newTestValue(indicatorID, countryID, date, 0)
+	iv.Null = true
+
+	return iv
+}
+
+func TestGroupSeries(t *testing.T) {
+	values := []*IndicatorValue{
+		newTestValue("NY.GDP.MKTP.CD", "USA", "2019", 21),
+		newTestNullValue("NY.GDP.MKTP.CD", "JPN", "2020"),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2019", 5),
+		newTestValue("SP.POP.TOTL", "JPN", "2019", 126),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2018", 4.9),
+	}
+
+	got, err := GroupSeries(values)
+	if err != nil {
+		t.Fatalf("GroupSeries() error = %v", err)
+	}
+
+	wantKeys := []SeriesKey{
+		{IndicatorID: "NY.GDP.MKTP.CD", CountryID: "JPN"},
+		{IndicatorID: "NY.GDP.MKTP.CD", CountryID: "USA"},
+		{IndicatorID: "SP.POP.TOTL", CountryID: "JPN"},
+	}
+	if keys := SeriesKeys(got); !reflect.DeepEqual(keys, wantKeys) {
+		t.Errorf("SeriesKeys() = %v, want %v", keys, wantKeys)
+	}
+
+	japan := got[wantKeys[0]]
+	if dates := japan.Dates(); !reflect.DeepEqual(dates, []string{"2018", "2019", "2020"}) {
+		t.Errorf("Series.Dates() = %v", dates)
+	}
+	if japan.Frequency != FrequencyYearly || japan.Len() != 3 {
+		t.Errorf("Series = %+v", japan)
+	}
+	if first := japan.First(); first != values[4] {
+		t.Errorf("Series.First() = %+v, want %+v", first, values[4])
+	}
+	if last := japan.Last(); last != values[2] {
+		t.Errorf("Series.Last() = %+v, want %+v", last, values[2])
+	}
+	if iv, ok := japan.At("2020"); !ok || !iv.IsNull() {
+		t.Errorf("Series.At() = %+v, %v, want null, true", iv, ok)
+	}
+	if iv, ok := japan.At("2017"); ok {
+		t.Errorf("Series.At() = %+v, %v, want nil, false", iv, ok)
+	}
+
+	if _, err := GroupSeries([]*IndicatorValue{
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2019", 5),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2019", 5),
+	}); err == nil {
+		t.Errorf("GroupSeries() error = nil, want error because of duplicated dates")
+	}
+	if _, err := GroupSeries([]*IndicatorValue{
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2019", 5),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2019Q1", 1),
+	}); err == nil {
+		t.Errorf("GroupSeries() error = nil, want error because of mixed frequencies")
+	}
+}
+
+func TestSeries_Slice(t *testing.T) {
+	s, err := NewSeries([]*IndicatorValue{
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2020Q1", 4),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2019Q4", 3),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2019Q3", 2),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2019Q2", 1),
+	})
+	if err != nil {
+		t.Fatalf("NewSeries() error = %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		dateRange *DateRange
+		want      []string
+		wantErr   bool
+	}{
+		{
+			name:      "success with quarters",
+			dateRange: &DateRange{Start: "2019Q3", End: "2020Q01"},
+			want:      []string{"2019Q3", "2019Q4", "2020Q1"},
+		},
+		{
+			name:      "success with a year",
+			dateRange: &DateRange{Start: "2019", End: "2019"},
+			want:      []string{"2019Q2", "2019Q3", "2019Q4"},
+		},
+		{
+			name:      "success with unbounded start",
+			dateRange: &DateRange{End: "2019Q2"},
+			want:      []string{"2019Q2"},
+		},
+		{
+			name:      "success without range",
+			dateRange: nil,
+			want:      []string{"2019Q2", "2019Q3", "2019Q4", "2020Q1"},
+		},
+		{
+			name:      "failure because invalid date",
+			dateRange: &DateRange{Start: "invalid_date"},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.Slice(tt.dateRange)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Series.Slice() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if dates := got.Dates(); !reflect.DeepEqual(dates, tt.want) {
+				t.Errorf("Series.Slice() = %v, want %v", dates, tt.want)
+			}
+			if got.Key != s.Key {
+				t.Errorf("Series.Slice() Key = %v, want %v", got.Key, s.Key)
+			}
+		})
+	}
+}
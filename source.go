@@ -1,7 +1,9 @@
 package wbdata
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 )
 
 type (
@@ -18,7 +20,32 @@ type (
 		URL                  string
 		DataAvailability     string
 		MetadataAvailability string
-		Concepts             string
+		// Concepts is the number of concepts (dimensions) of the source
+		Concepts intOrString
+	}
+
+	// Concept represents a concept (dimension) of a source, e.g. Country, Series and Time
+	Concept struct {
+		ID    string `json:"id"`
+		Value string `json:"value"`
+	}
+
+	conceptsResponse struct {
+		PageSummaryWithSourceID
+		Source conceptSources `json:"source"`
+	}
+
+	conceptSource struct {
+		ID       string                  `json:"id"`
+		Name     string                  `json:"name"`
+		Concepts []*conceptWithVariables `json:"concept"`
+	}
+
+	conceptSources []*conceptSource
+
+	conceptWithVariables struct {
+		Concept
+		Variables []*Variable `json:"variable"`
 	}
 )
 
@@ -60,3 +87,84 @@ func (s *SourcesService) Get(sourceID string) (*PageSummary, *Source, error) {
 
 	return summary, source[0], nil
 }
+
+// ListConcepts returns a Response's Summary and Concepts of a source
+func (s *SourcesService) ListConcepts(sourceID string, pages *PageParams) (*PageSummaryWithSourceID, []*Concept, error) {
+	path := fmt.Sprintf("sources/%s/concepts", sourceID)
+	summary, concepts, err := s.listConcepts(path, pages)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := []*Concept{}
+	for _, c := range concepts {
+		concept := c.Concept
+		result = append(result, &concept)
+	}
+
+	return summary, result, nil
+}
+
+// ListVariables returns a Response's Summary and Variables of a concept of a source,
+// e.g. countries for ConceptCountry and indicators for ConceptSeries
+func (s *SourcesService) ListVariables(
+	sourceID string,
+	conceptID string,
+	pages *PageParams,
+) (*PageSummaryWithSourceID, []*Variable, error) {
+	path := fmt.Sprintf("sources/%s/%s", sourceID, conceptID)
+	summary, concepts, err := s.listConcepts(path, pages)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	variables := []*Variable{}
+	for _, c := range concepts {
+		for _, v := range c.Variables {
+			if v.Concept == "" {
+				v.Concept = c.ID
+			}
+			variables = append(variables, v)
+		}
+	}
+
+	return summary, variables, nil
+}
+
+func (s *SourcesService) listConcepts(path string, pages *PageParams) (*PageSummaryWithSourceID, []*conceptWithVariables, error) {
+	req, err := s.client.NewRequest("GET", path, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := pages.addPageParams(req); err != nil {
+		return nil, nil, err
+	}
+
+	res := &conceptsResponse{}
+	if err = s.client.do(req, res); err != nil {
+		return nil, nil, err
+	}
+
+	summary := &res.PageSummaryWithSourceID
+	concepts := []*conceptWithVariables{}
+	for _, source := range res.Source {
+		summary.SourceID = source.ID
+		concepts = append(concepts, source.Concepts...)
+	}
+
+	return summary, concepts, nil
+}
+
+// Selector returns a DimensionSelector of the concept for AdvancedDataService.List.
+// If ids are empty, all variables are selected.
+func (c *Concept) Selector(ids ...string) *DimensionSelector {
+	return &DimensionSelector{
+		Concept: strings.ToLower(strings.ReplaceAll(c.ID, " ", "")),
+		IDs:     ids,
+	}
+}
+
+func (s *conceptSources) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(objectOrArray(data), (*[]*conceptSource)(s))
+}
@@ -67,7 +67,7 @@ func TestSourcesService_List(t *testing.T) {
 					URL:                  "", // NOTE: always empty?
 					DataAvailability:     "Y",
 					MetadataAvailability: "Y",
-					Concepts:             "3",
+					Concepts:             3,
 				},
 				{
 					ID:                   "36",
@@ -77,7 +77,7 @@ func TestSourcesService_List(t *testing.T) {
 					URL:                  "", // NOTE: always empty?
 					DataAvailability:     "Y",
 					MetadataAvailability: "",
-					Concepts:             "3",
+					Concepts:             3,
 				},
 			},
 			wantErr: false,
@@ -156,7 +156,7 @@ func TestSourcesService_Get(t *testing.T) {
 				URL:                  "", // NOTE: always empty?
 				DataAvailability:     "Y",
 				MetadataAvailability: "Y",
-				Concepts:             "3",
+				Concepts:             3,
 			},
 			wantErr:    false,
 			wantErrRes: nil,
@@ -206,3 +206,289 @@ func TestSourcesService_Get(t *testing.T) {
 		})
 	}
 }
+
+func TestSourcesService_ListConcepts(t *testing.T) {
+	client, save := NewTestClient(t, *update)
+	defer save()
+
+	optIgnoreFields := cmpopts.IgnoreFields(
+		PageSummaryWithSourceID{},
+		"Pages",
+		"Total",
+		"LastUpdated",
+	)
+
+	defaultPageParams := &PageParams{
+		Page:    testutils.TestDefaultPage,
+		PerPage: testutils.TestDefaultPerPage,
+	}
+
+	type args struct {
+		sourceID string
+		language string
+		pages    *PageParams
+	}
+	tests := []struct {
+		name       string
+		args       args
+		want       *PageSummaryWithSourceID
+		want1      []*Concept
+		wantErr    bool
+		wantErrRes *ErrorResponse
+	}{
+		{
+			name: "success",
+			args: args{
+				sourceID: testutils.TestDefaultSourceID,
+			},
+			want: &PageSummaryWithSourceID{
+				Page:     1,
+				PerPage:  50,
+				SourceID: "2",
+			},
+			want1: []*Concept{
+				{ID: "Country", Value: "Country"},
+				{ID: "Series", Value: "Series"},
+				{ID: "Time", Value: "Time"},
+			},
+			wantErr:    false,
+			wantErrRes: nil,
+		},
+		{
+			name: "success with page params",
+			args: args{
+				sourceID: testutils.TestDefaultSourceID,
+				pages:    defaultPageParams,
+			},
+			want: &PageSummaryWithSourceID{
+				Page:     intOrString(testutils.TestDefaultPage),
+				PerPage:  intOrString(testutils.TestDefaultPerPage),
+				SourceID: "2",
+			},
+			want1: []*Concept{
+				{ID: "Country", Value: "Country"},
+				{ID: "Series", Value: "Series"},
+			},
+			wantErr:    false,
+			wantErrRes: nil,
+		},
+		{
+			name: "success with language",
+			args: args{
+				sourceID: testutils.TestDefaultSourceID,
+				language: testutils.TestDefaultLanguageCode,
+			},
+			want: &PageSummaryWithSourceID{
+				Page:     1,
+				PerPage:  50,
+				SourceID: "2",
+			},
+			want1: []*Concept{
+				{ID: "Country", Value: "国"},
+				{ID: "Series", Value: "シリーズ"},
+				{ID: "Time", Value: "時間"},
+			},
+			wantErr:    false,
+			wantErrRes: nil,
+		},
+		{
+			name: "failure because invalid source id",
+			args: args{
+				sourceID: testutils.TestInvalidSourceID,
+			},
+			want:    nil,
+			want1:   nil,
+			wantErr: true,
+			wantErrRes: &ErrorResponse{
+				URL: fmt.Sprintf(
+					"%s%s/sources/%s/concepts?format=json",
+					defaultBaseURL,
+					apiVersion,
+					testutils.TestInvalidSourceID,
+				),
+				Code: 200,
+				Message: []ErrorMessage{
+					{
+						ID:    "120",
+						Key:   "Invalid value",
+						Value: "The provided parameter value is not valid",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client.Language = tt.args.language
+			defer func() { client.Language = "" }()
+
+			s := &SourcesService{
+				client: client,
+			}
+			got, got1, err := s.ListConcepts(tt.args.sourceID, tt.args.pages)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SourcesService.ListConcepts() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErrRes != nil {
+				if !reflect.DeepEqual(err, tt.wantErrRes) {
+					t.Errorf("SourcesService.ListConcepts() err = %v, wantErrRes %v", err, tt.wantErrRes)
+				}
+			}
+			if tt.want != nil && !cmp.Equal(got, tt.want, nil, optIgnoreFields) {
+				t.Errorf("SourcesService.ListConcepts() got = %+v, want %+v", got, tt.want)
+			}
+			if tt.want1 != nil && !reflect.DeepEqual(got1, tt.want1) {
+				t.Errorf("SourcesService.ListConcepts() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
+func TestSourcesService_ListVariables(t *testing.T) {
+	client, save := NewTestClient(t, *update)
+	defer save()
+
+	optIgnoreFields := cmpopts.IgnoreFields(
+		PageSummaryWithSourceID{},
+		"Pages",
+		"Total",
+		"LastUpdated",
+	)
+
+	defaultPageParams := &PageParams{
+		Page:    testutils.TestDefaultPage,
+		PerPage: testutils.TestDefaultPerPage,
+	}
+
+	type args struct {
+		sourceID  string
+		conceptID string
+		pages     *PageParams
+	}
+	tests := []struct {
+		name       string
+		args       args
+		want       *PageSummaryWithSourceID
+		want1      []*Variable
+		wantErr    bool
+		wantErrRes *ErrorResponse
+	}{
+		{
+			name: "success with country",
+			args: args{
+				sourceID:  testutils.TestDefaultSourceID,
+				conceptID: ConceptCountry,
+				pages:     defaultPageParams,
+			},
+			want: &PageSummaryWithSourceID{
+				Page:     intOrString(testutils.TestDefaultPage),
+				PerPage:  intOrString(testutils.TestDefaultPerPage),
+				SourceID: "2",
+			},
+			want1: []*Variable{
+				{Concept: "Country", ID: "ABW", Value: "Aruba"},
+				{Concept: "Country", ID: "AFE", Value: "Africa Eastern and Southern"},
+			},
+			wantErr:    false,
+			wantErrRes: nil,
+		},
+		{
+			name: "success with series",
+			args: args{
+				sourceID:  testutils.TestDefaultSourceID,
+				conceptID: ConceptSeries,
+				pages:     defaultPageParams,
+			},
+			want: &PageSummaryWithSourceID{
+				Page:     intOrString(testutils.TestDefaultPage),
+				PerPage:  intOrString(testutils.TestDefaultPerPage),
+				SourceID: "2",
+			},
+			want1: []*Variable{
+				{Concept: "Series", ID: "AG.AGR.TRAC.NO", Value: "Agricultural machinery, tractors"},
+				{Concept: "Series", ID: "AG.CON.FERT.PT.ZS", Value: "Fertilizer consumption (% of fertilizer production)"},
+			},
+			wantErr:    false,
+			wantErrRes: nil,
+		},
+		{
+			name: "failure because invalid source id",
+			args: args{
+				sourceID:  testutils.TestInvalidSourceID,
+				conceptID: ConceptCountry,
+			},
+			want:    nil,
+			want1:   nil,
+			wantErr: true,
+			wantErrRes: &ErrorResponse{
+				URL: fmt.Sprintf(
+					"%s%s/sources/%s/country?format=json",
+					defaultBaseURL,
+					apiVersion,
+					testutils.TestInvalidSourceID,
+				),
+				Code: 200,
+				Message: []ErrorMessage{
+					{
+						ID:    "120",
+						Key:   "Invalid value",
+						Value: "The provided parameter value is not valid",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &SourcesService{
+				client: client,
+			}
+			got, got1, err := s.ListVariables(tt.args.sourceID, tt.args.conceptID, tt.args.pages)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SourcesService.ListVariables() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErrRes != nil {
+				if !reflect.DeepEqual(err, tt.wantErrRes) {
+					t.Errorf("SourcesService.ListVariables() err = %v, wantErrRes %v", err, tt.wantErrRes)
+				}
+			}
+			if tt.want != nil && !cmp.Equal(got, tt.want, nil, optIgnoreFields) {
+				t.Errorf("SourcesService.ListVariables() got = %+v, want %+v", got, tt.want)
+			}
+			if tt.want1 != nil && !reflect.DeepEqual(got1, tt.want1) {
+				t.Errorf("SourcesService.ListVariables() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
+func TestConcept_Selector(t *testing.T) {
+	tests := []struct {
+		name    string
+		concept *Concept
+		ids     []string
+		want    *DimensionSelector
+	}{
+		{
+			name:    "all",
+			concept: &Concept{ID: "Country", Value: "Country"},
+			ids:     nil,
+			want:    &DimensionSelector{Concept: ConceptCountry},
+		},
+		{
+			name:    "with ids",
+			concept: &Concept{ID: "Series", Value: "Series"},
+			ids:     []string{testutils.TestDefaultIndicatorID},
+			want:    &DimensionSelector{Concept: ConceptSeries, IDs: []string{testutils.TestDefaultIndicatorID}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.concept.Selector(tt.ids...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Concept.Selector() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,238 @@
+package wbdata
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+const (
+	// UnitPercent is the unit of percent changes and growth rates
+	UnitPercent = "%"
+	// UnitLogDifference is the unit of log differences
+	UnitLogDifference = "log difference"
+
+	percentChangeSuffix = "PCH"
+	logDifferenceSuffix = "LDF"
+	differenceSuffix    = "DIF"
+	lagSuffix           = "LAG"
+	leadSuffix          = "LEAD"
+	cagrSuffix          = "CAGR"
+
+	percentScale = 100
+)
+
+// PercentChange returns a Series of the percent changes from n periods before, e.g. n = 1 for period-over-period.
+// A change is null if either value is null or missing, or the base value is zero.
+func (s *Series) PercentChange(n int) *Series {
+	return s.derive(percentChangeSuffix, n, "percent change over "+periodsText(n), UnitPercent,
+		func(p period, v float64) (float64, bool) {
+			base, ok := s.valueAt(p.add(-n))
+			if !ok || base == 0 {
+				return 0, false
+			}
+			return (v/base - 1) * percentScale, true
+		})
+}
+
+// YearOverYearChange returns a Series of the percent changes from the same period of the previous year,
+// e.g. from 2018Q3 to 2019Q3 for quarterly series
+func (s *Series) YearOverYearChange() *Series {
+	return s.PercentChange(s.perYear())
+}
+
+// LogDifference returns a Series of the differences of natural logarithms from n periods before.
+// A difference is null if either value is null, missing or not positive.
+func (s *Series) LogDifference(n int) *Series {
+	return s.derive(logDifferenceSuffix, n, "log difference over "+periodsText(n), UnitLogDifference,
+		func(p period, v float64) (float64, bool) {
+			base, ok := s.valueAt(p.add(-n))
+			if !ok || base <= 0 || v <= 0 {
+				return 0, false
+			}
+			return math.Log(v) - math.Log(base), true
+		})
+}
+
+// Difference returns a Series of the differences from n periods before in the unit of the series
+func (s *Series) Difference(n int) *Series {
+	return s.derive(differenceSuffix, n, "difference over "+periodsText(n), "",
+		func(p period, v float64) (float64, bool) {
+			base, ok := s.valueAt(p.add(-n))
+			if !ok {
+				return 0, false
+			}
+			return v - base, true
+		})
+}
+
+// Lag returns a Series whose value of each period is the value n periods before.
+// The periods of the series are kept, so values shifted out of the series are dropped.
+func (s *Series) Lag(n int) *Series {
+	if n < 0 {
+		return s.Lead(-n)
+	}
+
+	return s.shift(lagSuffix, n, "lagged by "+periodsText(n), -n)
+}
+
+// Lead returns a Series whose value of each period is the value n periods after
+func (s *Series) Lead(n int) *Series {
+	if n < 0 {
+		return s.Lag(-n)
+	}
+
+	return s.shift(leadSuffix, n, "led by "+periodsText(n), n)
+}
+
+// CAGR returns the compound annual growth rate in percent from the start date to the end date, dated the end date.
+// Years between the dates are counted by periods, e.g. 2 years from 2017Q1 to 2019Q1.
+// The rate is null if either value is null or not positive.
+func (s *Series) CAGR(start, end string) (*IndicatorValue, error) {
+	startPeriod, err := parsePeriod(start)
+	if err != nil {
+		return nil, err
+	}
+	endPeriod, err := parsePeriod(end)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkFrequency(startPeriod, endPeriod); err != nil {
+		return nil, err
+	}
+	if !startPeriod.before(endPeriod) {
+		return nil, fmt.Errorf("start should be before end, start: %s end: %s", start, end)
+	}
+
+	i := s.index(endPeriod)
+	if i < 0 {
+		return nil, fmt.Errorf("series %s has no value of %s", s.Key, end)
+	}
+	if s.index(startPeriod) < 0 {
+		return nil, fmt.Errorf("series %s has no value of %s", s.Key, start)
+	}
+
+	indicator := s.derivedIndicator(cagrSuffix, 0, fmt.Sprintf("compound annual growth rate since %s", startPeriod))
+	iv := derivedValue(indicator, s.Values[i], UnitPercent)
+	startValue, ok := s.valueAt(startPeriod)
+	endValue, ok2 := s.valueAt(endPeriod)
+	if !ok || !ok2 || startValue <= 0 || endValue <= 0 {
+		return iv, nil
+	}
+
+	years := float64(endPeriod.ordinal()-startPeriod.ordinal()) / float64(s.perYear())
+	iv.Value = (math.Pow(endValue/startValue, 1/years) - 1) * percentScale
+	iv.Null = false
+
+	return iv, nil
+}
+
+// TransformSeries applies the transform to each series and returns the results keyed by their derived keys
+func TransformSeries(series map[SeriesKey]*Series, transform func(*Series) *Series) map[SeriesKey]*Series {
+	transformed := make(map[SeriesKey]*Series, len(series))
+	for _, s := range series {
+		t := transform(s)
+		transformed[t.Key] = t
+	}
+
+	return transformed
+}
+
+// derive returns a Series of the same periods whose values are computed by fn from the non-null values.
+// Null values stay null.
+func (s *Series) derive(suffix string, n int, name, unit string, fn func(p period, v float64) (float64, bool)) *Series {
+	derived := s.derivedSeries(suffix, n, name)
+	for i, iv := range s.Values {
+		dv := derivedValue(derived.Indicator, iv, unit)
+		if !iv.IsNull() {
+			if v, ok := fn(s.periods[i], iv.Value); ok {
+				dv.Value = v
+				dv.Null = false
+			}
+		}
+		derived.Values = append(derived.Values, dv)
+	}
+
+	return derived
+}
+
+// shift returns a Series of the same periods whose values are the ones n periods after
+func (s *Series) shift(suffix string, n int, name string, offset int) *Series {
+	derived := s.derivedSeries(suffix, n, name)
+	for i, iv := range s.Values {
+		dv := derivedValue(derived.Indicator, iv, "")
+		if j := s.index(s.periods[i].add(offset)); j >= 0 && !s.Values[j].IsNull() {
+			dv.Value = s.Values[j].Value
+			dv.Null = false
+		}
+		derived.Values = append(derived.Values, dv)
+	}
+
+	return derived
+}
+
+func (s *Series) derivedSeries(suffix string, n int, name string) *Series {
+	derived := s.withValues(make([]*IndicatorValue, 0, len(s.Values)), append([]period{}, s.periods...))
+	derived.Indicator = s.derivedIndicator(suffix, n, name)
+	derived.Key.IndicatorID = derived.Indicator.ID
+
+	return derived
+}
+
+// derivedIndicator returns the indicator derived from the indicator of the series.
+// The ID is such as NY.GDP.MKTP.CD.PCH1, or NY.GDP.MKTP.CD.CAGR if n is 0,
+// and the name is such as "GDP (current US$), percent change over 1 period".
+func (s *Series) derivedIndicator(suffix string, n int, name string) IDAndValue {
+	id := s.Indicator.ID + "." + suffix
+	if n != 0 {
+		id += strconv.Itoa(n)
+	}
+	base := s.Indicator.Value
+	if base == "" {
+		base = s.Indicator.ID
+	}
+
+	return IDAndValue{ID: id, Value: base + ", " + name}
+}
+
+// derivedValue returns a null value of the indicator of the same country and date as iv.
+// An empty unit means the unit of iv.
+func derivedValue(indicator IDAndValue, iv *IndicatorValue, unit string) *IndicatorValue {
+	if unit == "" {
+		unit = iv.Unit
+	}
+
+	return &IndicatorValue{
+		Indicator:       indicator,
+		Country:         iv.Country,
+		Countryiso3code: iv.Countryiso3code,
+		Date:            iv.Date,
+		Unit:            unit,
+		Decimal:         iv.Decimal,
+		Null:            true,
+	}
+}
+
+// valueAt returns the value of the period, and false if it is missing or null
+func (s *Series) valueAt(p period) (float64, bool) {
+	i := s.index(p)
+	if i < 0 || s.Values[i].IsNull() {
+		return 0, false
+	}
+
+	return s.Values[i].Value, true
+}
+
+// perYear returns the number of periods in a year of the series
+func (s *Series) perYear() int {
+	return period{frequency: s.Frequency}.perYear()
+}
+
+// periodsText returns a text such as "1 period" and "4 periods"
+func periodsText(n int) string {
+	if n == 1 {
+		return "1 period"
+	}
+
+	return strconv.Itoa(n) + " periods"
+}
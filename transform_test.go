@@ -0,0 +1,238 @@
+package wbdata
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+// seriesValues returns the values of the series, with NaN for nulls
+func seriesValues(s *Series) []float64 {
+	values := make([]float64, len(s.Values))
+	for i, iv := range s.Values {
+		values[i] = iv.Value
+		if iv.IsNull() {
+			values[i] = math.NaN()
+		}
+	}
+
+	return values
+}
+
+func equalValues(got, want []float64) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if math.IsNaN(got[i]) != math.IsNaN(want[i]) {
+			return false
+		}
+		if !math.IsNaN(got[i]) && math.Abs(got[i]-want[i]) > 1e-9 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestSeries_Transforms(t *testing.T) {
+	nan := math.NaN()
+	yearly, err := NewSeries([]*IndicatorValue{
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2016", 100),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2017", 110),
+		newTestNullValue("NY.GDP.MKTP.CD", "JPN", "2018"),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2019", 121),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2020", 0),
+	})
+	if err != nil {
+		t.Fatalf("NewSeries() error = %v", err)
+	}
+	// 2019Q2 is missing
+	quarterly, err := NewSeries([]*IndicatorValue{
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2019Q1", 10),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2019Q3", 12),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2019Q4", 13),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2020Q1", 15),
+	})
+	if err != nil {
+		t.Fatalf("NewSeries() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		got      *Series
+		wantID   string
+		wantUnit string
+		want     []float64
+	}{
+		{
+			name:     "percent change",
+			got:      yearly.PercentChange(1),
+			wantID:   "NY.GDP.MKTP.CD.PCH1",
+			wantUnit: UnitPercent,
+			want:     []float64{nan, 10, nan, nan, -100},
+		},
+		{
+			name:     "percent change over 2 periods skips a null",
+			got:      yearly.PercentChange(2),
+			wantID:   "NY.GDP.MKTP.CD.PCH2",
+			wantUnit: UnitPercent,
+			want:     []float64{nan, nan, nan, 10, nan},
+		},
+		{
+			name:     "log difference",
+			got:      yearly.LogDifference(2),
+			wantID:   "NY.GDP.MKTP.CD.LDF2",
+			wantUnit: UnitLogDifference,
+			want:     []float64{nan, nan, nan, math.Log(1.1), nan},
+		},
+		{
+			name:   "difference",
+			got:    yearly.Difference(1),
+			wantID: "NY.GDP.MKTP.CD.DIF1",
+			want:   []float64{nan, 10, nan, nan, -121},
+		},
+		{
+			name:   "lag",
+			got:    yearly.Lag(1),
+			wantID: "NY.GDP.MKTP.CD.LAG1",
+			want:   []float64{nan, 100, 110, nan, 121},
+		},
+		{
+			name:   "lead",
+			got:    yearly.Lead(1),
+			wantID: "NY.GDP.MKTP.CD.LEAD1",
+			want:   []float64{110, nan, 121, 0, nan},
+		},
+		{
+			name:     "quarterly period over period is period-aware",
+			got:      quarterly.PercentChange(1),
+			wantID:   "NY.GDP.MKTP.CD.PCH1",
+			wantUnit: UnitPercent,
+			want:     []float64{nan, nan, 100.0 / 12, 100.0 * 2 / 13},
+		},
+		{
+			name:     "quarterly year over year",
+			got:      quarterly.YearOverYearChange(),
+			wantID:   "NY.GDP.MKTP.CD.PCH4",
+			wantUnit: UnitPercent,
+			want:     []float64{nan, nan, nan, 50},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := seriesValues(tt.got); !equalValues(got, tt.want) {
+				t.Errorf("values = %v, want %v", got, tt.want)
+			}
+			if tt.got.Key.IndicatorID != tt.wantID || tt.got.Key.CountryID != "JPN" {
+				t.Errorf("Key = %v, want ID %v", tt.got.Key, tt.wantID)
+			}
+			for _, iv := range tt.got.Values {
+				if iv.Indicator.ID != tt.wantID || iv.Unit != tt.wantUnit {
+					t.Errorf("value = %+v, want ID %v and unit %v", iv, tt.wantID, tt.wantUnit)
+				}
+			}
+		})
+	}
+
+	if got := yearly.PercentChange(1).Indicator.Value; got != "NY.GDP.MKTP.CD, percent change over 1 period" {
+		t.Errorf("Indicator.Value = %v", got)
+	}
+	if !reflect.DeepEqual(yearly.Lag(1).Dates(), yearly.Dates()) {
+		t.Errorf("Series.Lag() Dates = %v, want %v", yearly.Lag(1).Dates(), yearly.Dates())
+	}
+}
+
+func TestSeries_CAGR(t *testing.T) {
+	s, err := NewSeries([]*IndicatorValue{
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2017Q1", 100),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2018Q1", 105),
+		newTestNullValue("NY.GDP.MKTP.CD", "JPN", "2018Q2"),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2019Q1", 121),
+	})
+	if err != nil {
+		t.Fatalf("NewSeries() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		start    string
+		end      string
+		want     float64
+		wantNull bool
+		wantErr  bool
+	}{
+		{
+			name:  "success over 2 years",
+			start: "2017Q1",
+			end:   "2019Q1",
+			want:  10,
+		},
+		{
+			name:     "success with null",
+			start:    "2017Q1",
+			end:      "2018Q2",
+			wantNull: true,
+		},
+		{
+			name:    "failure because of another frequency",
+			start:   "2017",
+			end:     "2019",
+			wantErr: true,
+		},
+		{
+			name:    "failure because start is after end",
+			start:   "2019Q1",
+			end:     "2017Q1",
+			wantErr: true,
+		},
+		{
+			name:    "failure because of a missing date",
+			start:   "2017Q2",
+			end:     "2019Q1",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.CAGR(tt.start, tt.end)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Series.CAGR() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.IsNull() != tt.wantNull || math.Abs(got.Value-tt.want) > 1e-9 {
+				t.Errorf("Series.CAGR() = %+v, want %v", got, tt.want)
+			}
+			if got.Indicator.ID != "NY.GDP.MKTP.CD.CAGR" || got.Date != tt.end || got.Unit != UnitPercent {
+				t.Errorf("Series.CAGR() = %+v", got)
+			}
+		})
+	}
+}
+
+func TestTransformSeries(t *testing.T) {
+	series, err := GroupSeries([]*IndicatorValue{
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2018", 100),
+		newTestValue("NY.GDP.MKTP.CD", "JPN", "2019", 110),
+		newTestValue("NY.GDP.MKTP.CD", "USA", "2018", 200),
+		newTestValue("NY.GDP.MKTP.CD", "USA", "2019", 190),
+	})
+	if err != nil {
+		t.Fatalf("GroupSeries() error = %v", err)
+	}
+
+	got := TransformSeries(series, func(s *Series) *Series { return s.PercentChange(1) })
+	wantKeys := []SeriesKey{
+		{IndicatorID: "NY.GDP.MKTP.CD.PCH1", CountryID: "JPN"},
+		{IndicatorID: "NY.GDP.MKTP.CD.PCH1", CountryID: "USA"},
+	}
+	if keys := SeriesKeys(got); !reflect.DeepEqual(keys, wantKeys) {
+		t.Errorf("TransformSeries() keys = %v, want %v", keys, wantKeys)
+	}
+	if v := seriesValues(got[wantKeys[1]]); !equalValues(v, []float64{math.NaN(), -5}) {
+		t.Errorf("TransformSeries() values = %v", v)
+	}
+}
@@ -23,6 +23,11 @@ const (
 	apiVersion      = "v2"
 	userAgent       = "wbdata-go"
 	defaultFormat   = OutputFormatJSON
+
+	// defaultProjectsBaseURL is the base URL of the Projects & Operations API
+	defaultProjectsBaseURL = defaultProtocol + "://search.worldbank.org/api/v2/"
+	// defaultDocumentsBaseURL is the base URL of the Documents & Reports API
+	defaultDocumentsBaseURL = defaultProtocol + "://search.worldbank.org/api/v2/"
 )
 
 // A Client manages communication with the World Bank Open Data API
@@ -32,6 +37,12 @@ type Client struct {
 	// BaseURL is URL for API requests. Defaults to the World Bank Open Data API
 	BaseURL *url.URL
 
+	// ProjectsBaseURL is URL for the Projects & Operations API requests
+	ProjectsBaseURL *url.URL
+
+	// DocumentsBaseURL is URL for the Documents & Reports API requests
+	DocumentsBaseURL *url.URL
+
 	// Language is Local Language for response
 	Language string
 
@@ -58,6 +69,10 @@ type Client struct {
 	Sources         *SourcesService
 	Topics          *TopicsService
 	Languages       *LanguagesService
+	AdvancedData    *AdvancedDataService
+	Metadata        *MetadataService
+	Projects        *ProjectsService
+	Documents       *DocumentsService
 }
 
 type service struct {
@@ -71,6 +86,20 @@ func SetLanguage(lang *Language) func(*Client) {
 	}
 }
 
+// SetProjectsBaseURL sets URL for the Projects & Operations API requests, e.g. a local stand-in for testing
+func SetProjectsBaseURL(u *url.URL) func(*Client) {
+	return func(s *Client) {
+		s.ProjectsBaseURL = u
+	}
+}
+
+// SetDocumentsBaseURL sets URL for the Documents & Reports API requests, e.g. a local stand-in for testing
+func SetDocumentsBaseURL(u *url.URL) func(*Client) {
+	return func(s *Client) {
+		s.DocumentsBaseURL = u
+	}
+}
+
 // NOTE: default format is json
 // SetOutputFormat sets local language to request URL
 // func SetOutputFormat(format OutputFormat, prefix string) func(*Client) {
@@ -86,10 +115,32 @@ func NewClient(httpClient *http.Client, options ...func(*Client)) *Client {
 		httpClient = &http.Client{}
 	}
 	baseURL, _ := url.Parse(defaultBaseURL + apiVersion + "/")
-	c := &Client{client: httpClient, BaseURL: baseURL, OutputFormat: defaultFormat, UserAgent: userAgent}
+	projectsBaseURL, _ := url.Parse(defaultProjectsBaseURL)
+	documentsBaseURL, _ := url.Parse(defaultDocumentsBaseURL)
+	c := &Client{
+		client:           httpClient,
+		BaseURL:          baseURL,
+		ProjectsBaseURL:  projectsBaseURL,
+		DocumentsBaseURL: documentsBaseURL,
+		OutputFormat:     defaultFormat,
+		UserAgent:        userAgent,
+	}
 	for _, option := range options {
 		option(c)
 	}
+	c.setServices()
+	return c
+}
+
+// withLanguage returns a copy of the client with local language
+func (c *Client) withLanguage(language string) *Client {
+	lc := *c
+	lc.Language = language
+	lc.setServices()
+	return &lc
+}
+
+func (c *Client) setServices() {
 	c.Countries = &CountriesService{client: c}
 	c.Sources = &SourcesService{client: c}
 	c.Topics = &TopicsService{client: c}
@@ -99,7 +150,10 @@ func NewClient(httpClient *http.Client, options ...func(*Client)) *Client {
 	c.IncomeLevels = &IncomeLevelsService{client: c}
 	c.LendingTypes = &LendingTypesService{client: c}
 	c.Regions = &RegionsService{client: c}
-	return c
+	c.AdvancedData = &AdvancedDataService{client: c}
+	c.Metadata = &MetadataService{client: c}
+	c.Projects = &ProjectsService{client: c}
+	c.Documents = &DocumentsService{client: c}
 }
 
 // NewRequest returns a new World Bank Open Data API http request.
@@ -147,6 +201,37 @@ func (c *Client) NewRequest(
 	return req, nil
 }
 
+// newSearchRequest returns a new http request for the search APIs of the World Bank, e.g. the Projects & Operations API.
+// They don't support the local language in the path.
+func (c *Client) newSearchRequest(baseURL *url.URL, urlStr string, queryParams map[string]string) (*http.Request, error) {
+	if baseURL == nil || !strings.HasSuffix(baseURL.Path, "/") {
+		return nil, fmt.Errorf("base URL must have a trailing slash, but %q does not", baseURL)
+	}
+
+	u, err := baseURL.Parse(urlStr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse from %s: %v", urlStr, err)
+	}
+
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	params := req.URL.Query()
+	params.Set(`format`, OutputFormatJSON.String())
+	for k, v := range queryParams {
+		if v != "" {
+			params.Set(k, v)
+		}
+	}
+	req.URL.RawQuery = params.Encode()
+
+	setHeader(c, req, nil)
+
+	return req, nil
+}
+
 func (c *Client) buildRequestURL(urlStr string) (string, error) {
 	// Set local language
 	if c.Language != "" {
@@ -169,7 +254,7 @@ func setHeader(c *Client, req *http.Request, body interface{}) {
 	}
 }
 
-func (c *Client) do(req *http.Request, v *[]interface{}) error {
+func (c *Client) do(req *http.Request, v interface{}) error {
 	resp, err := c.client.Do(req)
 	if err != nil {
 		return err
@@ -205,6 +290,63 @@ func (c *Client) do(req *http.Request, v *[]interface{}) error {
 	return nil
 }
 
+func (c *Client) download(req *http.Request, w io.Writer) error {
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := checkStatusCode(resp); err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		return fmt.Errorf("failed to download from %q: %v", req.URL, err)
+	}
+
+	return nil
+}
+
+// pager requests pages one by one until the last page of the summary
+type pager struct {
+	perPage int
+	// page is the next page to request
+	page    int
+	summary *PageSummary
+}
+
+func newPager(perPage int) *pager {
+	return &pager{perPage: perPage, page: 1}
+}
+
+// next calls list with the next page. It returns false without calling list after the last page.
+func (p *pager) next(list func(pages *PageParams) (*PageSummary, error)) (bool, error) {
+	if p.summary != nil && p.page > int(p.summary.Pages) {
+		return false, nil
+	}
+
+	summary, err := list(&PageParams{Page: p.page, PerPage: p.perPage})
+	if err != nil {
+		return false, err
+	}
+	p.summary = summary
+	p.page++
+
+	return true, nil
+}
+
+// forEachPage calls list with each page until the last page
+func forEachPage(perPage int, list func(pages *PageParams) (*PageSummary, error)) error {
+	p := newPager(perPage)
+	for {
+		ok, err := p.next(list)
+		if err != nil || !ok {
+			return err
+		}
+	}
+}
+
 func checkStatusCode(resp *http.Response) error {
 	// NOTE: StatusCode is 'always' 200 Eeven if ErrorMessage exists.
 	if c := resp.StatusCode; 200 <= c && c <= 299 {
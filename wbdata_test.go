@@ -14,6 +14,8 @@ import (
 
 func TestNewClient(t *testing.T) {
 	baseURL, _ := url.Parse(defaultBaseURL + apiVersion + "/")
+	projectsBaseURL, _ := url.Parse(defaultProjectsBaseURL)
+	documentsBaseURL, _ := url.Parse(defaultDocumentsBaseURL)
 	jaLanguage := &Language{
 		Code: "ja",
 	}
@@ -28,6 +30,10 @@ func TestNewClient(t *testing.T) {
 		"Sources",
 		"Topics",
 		"Languages",
+		"AdvancedData",
+		"Metadata",
+		"Projects",
+		"Documents",
 	)
 
 	type args struct {
@@ -46,10 +52,12 @@ func TestNewClient(t *testing.T) {
 				options:    nil,
 			},
 			want: &Client{
-				client:       &http.Client{},
-				BaseURL:      baseURL,
-				OutputFormat: OutputFormatJSON,
-				UserAgent:    userAgent,
+				client:           &http.Client{},
+				BaseURL:          baseURL,
+				ProjectsBaseURL:  projectsBaseURL,
+				DocumentsBaseURL: documentsBaseURL,
+				OutputFormat:     OutputFormatJSON,
+				UserAgent:        userAgent,
 			},
 		},
 		{
@@ -61,11 +69,13 @@ func TestNewClient(t *testing.T) {
 				},
 			},
 			want: &Client{
-				client:       &http.Client{},
-				BaseURL:      baseURL,
-				Language:     testutils.TestDefaultLanguageCode,
-				OutputFormat: OutputFormatJSON,
-				UserAgent:    userAgent,
+				client:           &http.Client{},
+				BaseURL:          baseURL,
+				ProjectsBaseURL:  projectsBaseURL,
+				DocumentsBaseURL: documentsBaseURL,
+				Language:         testutils.TestDefaultLanguageCode,
+				OutputFormat:     OutputFormatJSON,
+				UserAgent:        userAgent,
 			},
 		},
 	}
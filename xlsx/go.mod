@@ -0,0 +1,22 @@
+module github.com/jkkitakita/wbdata-go/xlsx
+
+// golang.org/x/crypto v0.28.0, which excelize v2.9.0 requires, needs go 1.20
+go 1.20
+
+require (
+	github.com/jkkitakita/wbdata-go v0.2.0
+	github.com/xuri/excelize/v2 v2.9.0
+)
+
+require (
+	github.com/dnaeon/go-vcr v1.2.0 // indirect
+	github.com/mohae/deepcopy v0.0.0-20170929034955-c48cc78d4826 // indirect
+	github.com/richardlehane/mscfb v1.0.4 // indirect
+	github.com/richardlehane/msoleps v1.0.4 // indirect
+	github.com/xuri/efp v0.0.0-20240408161823-9ad904a10d6d // indirect
+	github.com/xuri/nfp v0.0.0-20240318013403-ab9948c2c4a7 // indirect
+	golang.org/x/crypto v0.28.0 // indirect
+	golang.org/x/net v0.30.0 // indirect
+	golang.org/x/text v0.19.0 // indirect
+	gopkg.in/yaml.v2 v2.4.0 // indirect
+)
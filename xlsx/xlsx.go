@@ -0,0 +1,219 @@
+// Package xlsx writes indicator values of wbdata to XLSX workbooks.
+// It is a separate module so that the wbdata package does not depend on excelize.
+package xlsx
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/xuri/excelize/v2"
+
+	"github.com/jkkitakita/wbdata-go"
+)
+
+const (
+	// SheetData is the sheet name for pivoted indicator values
+	SheetData = "Data"
+	// SheetIndicators is the sheet name for indicator metadata
+	SheetIndicators = "Indicators"
+	// SheetCountries is the sheet name for country attributes
+	SheetCountries = "Countries"
+
+	defaultSheet = "Sheet1"
+)
+
+var (
+	dataHeader       = []string{"Country ID", "Country Name", "Indicator ID", "Indicator Name"}
+	indicatorsHeader = []string{"ID", "Name", "Unit", "Source Note", "Source Organization"}
+	countriesHeader  = []string{"ID", "Name", "Region", "Income Level", "Lending Type"}
+)
+
+type dataRow struct {
+	country   wbdata.IDAndValue
+	indicator wbdata.IDAndValue
+	values    map[string]*wbdata.IndicatorValue
+}
+
+// WriteIndicatorValues writes an XLSX workbook to w.
+// The Data sheet holds indicator values pivoted by country and indicator in rows and dates in columns,
+// the Indicators sheet holds indicator metadata and the Countries sheet holds country attributes.
+// Null values are written as empty cells.
+func WriteIndicatorValues(
+	w io.Writer,
+	indicatorValues []*wbdata.IndicatorValue,
+	indicators []*wbdata.Indicator,
+	countries []*wbdata.Country,
+) error {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	if err := f.SetSheetName(defaultSheet, SheetData); err != nil {
+		return err
+	}
+	if err := writeData(f, indicatorValues); err != nil {
+		return err
+	}
+
+	if _, err := f.NewSheet(SheetIndicators); err != nil {
+		return err
+	}
+	if err := writeIndicators(f, indicators); err != nil {
+		return err
+	}
+
+	if _, err := f.NewSheet(SheetCountries); err != nil {
+		return err
+	}
+	if err := writeCountries(f, countries); err != nil {
+		return err
+	}
+
+	if err := f.Write(w); err != nil {
+		return fmt.Errorf("failed to write xlsx: %v", err)
+	}
+
+	return nil
+}
+
+func writeData(f *excelize.File, indicatorValues []*wbdata.IndicatorValue) error {
+	rows := []*dataRow{}
+	rowsByKey := map[string]*dataRow{}
+	dates := []string{}
+	seenDates := map[string]bool{}
+
+	for _, iv := range indicatorValues {
+		country := wbdata.IDAndValue{ID: iv.Countryiso3code, Value: iv.Country.Value}
+		if country.ID == "" {
+			country.ID = iv.Country.ID
+		}
+
+		key := country.ID + "\x00" + iv.Indicator.ID
+		row, ok := rowsByKey[key]
+		if !ok {
+			row = &dataRow{
+				country:   country,
+				indicator: iv.Indicator,
+				values:    map[string]*wbdata.IndicatorValue{},
+			}
+			rowsByKey[key] = row
+			rows = append(rows, row)
+		}
+		row.values[iv.Date] = iv
+
+		if !seenDates[iv.Date] {
+			seenDates[iv.Date] = true
+			dates = append(dates, iv.Date)
+		}
+	}
+	sort.Strings(dates)
+
+	header := make([]interface{}, 0, len(dataHeader)+len(dates))
+	for _, h := range dataHeader {
+		header = append(header, h)
+	}
+	for _, date := range dates {
+		header = append(header, date)
+	}
+	if err := f.SetSheetRow(SheetData, "A1", &header); err != nil {
+		return err
+	}
+
+	for i, row := range rows {
+		cells := []interface{}{row.country.ID, row.country.Value, row.indicator.ID, row.indicator.Value}
+		if err := setRow(f, SheetData, i+2, cells); err != nil {
+			return err
+		}
+
+		for j, date := range dates {
+			iv, ok := row.values[date]
+			if !ok || iv.IsNull() {
+				continue
+			}
+			cell, err := excelize.CoordinatesToCellName(len(dataHeader)+j+1, i+2)
+			if err != nil {
+				return err
+			}
+			if err := f.SetCellFloat(SheetData, cell, iv.Value, -1, 64); err != nil {
+				return err
+			}
+		}
+	}
+
+	topLeftCell, err := excelize.CoordinatesToCellName(len(dataHeader)+1, 2)
+	if err != nil {
+		return err
+	}
+
+	return f.SetPanes(SheetData, &excelize.Panes{
+		Freeze:      true,
+		XSplit:      len(dataHeader),
+		YSplit:      1,
+		TopLeftCell: topLeftCell,
+		ActivePane:  "bottomRight",
+	})
+}
+
+func writeIndicators(f *excelize.File, indicators []*wbdata.Indicator) error {
+	if err := setHeader(f, SheetIndicators, indicatorsHeader); err != nil {
+		return err
+	}
+
+	for i, indicator := range indicators {
+		cells := []interface{}{
+			indicator.ID,
+			indicator.Name,
+			indicator.Unit,
+			indicator.SourceNote,
+			indicator.SourceOrganization,
+		}
+		if err := setRow(f, SheetIndicators, i+2, cells); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeCountries(f *excelize.File, countries []*wbdata.Country) error {
+	if err := setHeader(f, SheetCountries, countriesHeader); err != nil {
+		return err
+	}
+
+	for i, country := range countries {
+		cells := []interface{}{
+			country.ID,
+			country.Name,
+			country.Region.Value,
+			country.IncomeLevel.Value,
+			country.LendingType.Value,
+		}
+		if err := setRow(f, SheetCountries, i+2, cells); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func setHeader(f *excelize.File, sheet string, header []string) error {
+	if err := f.SetSheetRow(sheet, "A1", &header); err != nil {
+		return err
+	}
+
+	return f.SetPanes(sheet, &excelize.Panes{
+		Freeze:      true,
+		YSplit:      1,
+		TopLeftCell: "A2",
+		ActivePane:  "bottomLeft",
+	})
+}
+
+func setRow(f *excelize.File, sheet string, row int, cells []interface{}) error {
+	cell, err := excelize.CoordinatesToCellName(1, row)
+	if err != nil {
+		return err
+	}
+
+	return f.SetSheetRow(sheet, cell, &cells)
+}
@@ -0,0 +1,147 @@
+package xlsx
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/xuri/excelize/v2"
+
+	"github.com/jkkitakita/wbdata-go"
+)
+
+func newTestIndicatorValues() []*wbdata.IndicatorValue {
+	return []*wbdata.IndicatorValue{
+		{
+			Indicator: wbdata.IDAndValue{
+				ID:    "NY.GDP.MKTP.CD",
+				Value: "GDP (current US$)",
+			},
+			Country: wbdata.IDAndValue{
+				ID:    "JP",
+				Value: "Japan",
+			},
+			Countryiso3code: "JPN",
+			Date:            "2020",
+			Null:            true,
+		},
+		{
+			Indicator: wbdata.IDAndValue{
+				ID:    "NY.GDP.MKTP.CD",
+				Value: "GDP (current US$)",
+			},
+			Country: wbdata.IDAndValue{
+				ID:    "JP",
+				Value: "Japan",
+			},
+			Countryiso3code: "JPN",
+			Date:            "2019",
+			Value:           5.08176954237977e+12,
+		},
+	}
+}
+
+func TestWriteIndicatorValues(t *testing.T) {
+	indicatorValues := append(newTestIndicatorValues(), &wbdata.IndicatorValue{
+		Indicator: wbdata.IDAndValue{
+			ID:    "NY.GDP.MKTP.CD",
+			Value: "GDP (current US$)",
+		},
+		Country: wbdata.IDAndValue{
+			ID:    "US",
+			Value: "United States",
+		},
+		Countryiso3code: "USA",
+		Date:            "2020",
+		Value:           2.09366e+13,
+	})
+	indicators := []*wbdata.Indicator{
+		{
+			ID:                 "NY.GDP.MKTP.CD",
+			Name:               "GDP (current US$)",
+			SourceNote:         "GDP at purchaser's prices is the sum of gross value added",
+			SourceOrganization: "World Bank national accounts data",
+		},
+	}
+	countries := []*wbdata.Country{
+		{
+			ID:          "JPN",
+			Name:        "Japan",
+			Region:      wbdata.CountryRegion{ID: "EAS", Value: "East Asia & Pacific"},
+			IncomeLevel: wbdata.IncomeLevel{ID: "HIC", Value: "High income"},
+			LendingType: wbdata.LendingType{ID: "LNX", Value: "Not classified"},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteIndicatorValues(&buf, indicatorValues, indicators, countries); err != nil {
+		t.Fatalf("WriteIndicatorValues() error = %v", err)
+	}
+
+	f, err := excelize.OpenReader(&buf)
+	if err != nil {
+		t.Fatalf("excelize.OpenReader() error = %v", err)
+	}
+	defer f.Close()
+
+	tests := []struct {
+		sheet string
+		want  [][]string
+	}{
+		{
+			sheet: SheetData,
+			want: [][]string{
+				{"Country ID", "Country Name", "Indicator ID", "Indicator Name", "2019", "2020"},
+				{"JPN", "Japan", "NY.GDP.MKTP.CD", "GDP (current US$)", "5081769542379.77"},
+				{"USA", "United States", "NY.GDP.MKTP.CD", "GDP (current US$)", "", "20936600000000"},
+			},
+		},
+		{
+			sheet: SheetIndicators,
+			want: [][]string{
+				{"ID", "Name", "Unit", "Source Note", "Source Organization"},
+				{
+					"NY.GDP.MKTP.CD",
+					"GDP (current US$)",
+					"",
+					"GDP at purchaser's prices is the sum of gross value added",
+					"World Bank national accounts data",
+				},
+			},
+		},
+		{
+			sheet: SheetCountries,
+			want: [][]string{
+				{"ID", "Name", "Region", "Income Level", "Lending Type"},
+				{"JPN", "Japan", "East Asia & Pacific", "High income", "Not classified"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sheet, func(t *testing.T) {
+			got, err := f.GetRows(tt.sheet)
+			if err != nil {
+				t.Fatalf("excelize.File.GetRows() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("WriteIndicatorValues() rows = %q, want %q", got, tt.want)
+			}
+
+			panes, err := f.GetPanes(tt.sheet)
+			if err != nil {
+				t.Fatalf("excelize.File.GetPanes() error = %v", err)
+			}
+			if !panes.Freeze || panes.YSplit != 1 {
+				t.Errorf("WriteIndicatorValues() panes = %+v, want frozen header", panes)
+			}
+		})
+	}
+
+	cell, err := f.GetCellValue(SheetData, "F2")
+	if err != nil {
+		t.Fatalf("excelize.File.GetCellValue() error = %v", err)
+	}
+	if cell != "" {
+		t.Errorf("WriteIndicatorValues() null value = %q, want empty cell", cell)
+	}
+}